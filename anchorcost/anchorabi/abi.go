@@ -0,0 +1,236 @@
+// Package anchorabi decodes the calls that factomd makes to its anchor
+// contract on ethereum. The contract is described by its json ABI, so only
+// the argument names of the anchor function have to be known.
+package anchorabi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"golang.org/x/crypto/sha3"
+)
+
+var (
+	ErrShortInput    = errors.New("input shorter than a selector")
+	ErrUnknownMethod = errors.New("unknown method")
+	ErrInputSize     = errors.New("wrong input size")
+	ErrBadValue      = errors.New("invalid argument value")
+	ErrUnsupported   = errors.New("unsupported argument type")
+	ErrNoArgument    = errors.New("no such argument")
+)
+
+// Argument is an input of a contract function
+type Argument struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// Method is a function of a contract
+type Method struct {
+	Name     string
+	Inputs   []Argument
+	Selector [4]byte
+}
+
+// Signature returns the canonical form of the method that the selector is
+// the hash of, like "setAnchor(uint256,uint256)"
+func (m *Method) Signature() string {
+	types := make([]string, len(m.Inputs))
+	for i, in := range m.Inputs {
+		types[i] = in.Type
+	}
+	return m.Name + "(" + strings.Join(types, ",") + ")"
+}
+
+// ABI holds the functions of a contract by selector
+type ABI struct {
+	Methods map[[4]byte]*Method
+}
+
+type abiEntry struct {
+	Type   string     `json:"type"`
+	Name   string     `json:"name"`
+	Inputs []Argument `json:"inputs"`
+}
+
+// ParseABI reads a json ABI. Events, constructors and other entries that
+// are not functions are ignored.
+func ParseABI(data []byte) (*ABI, error) {
+	var entries []abiEntry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("abi: %w", err)
+	}
+
+	a := &ABI{Methods: make(map[[4]byte]*Method)}
+	for _, e := range entries {
+		// a missing type means function
+		if e.Type != "function" && e.Type != "" {
+			continue
+		}
+		m := &Method{Name: e.Name, Inputs: e.Inputs}
+		for i := range m.Inputs {
+			m.Inputs[i].Type = canonicalType(m.Inputs[i].Type)
+		}
+		h := sha3.NewLegacyKeccak256()
+		h.Write([]byte(m.Signature()))
+		copy(m.Selector[:], h.Sum(nil))
+		a.Methods[m.Selector] = m
+	}
+	return a, nil
+}
+
+// Method returns the function with the given name
+func (a *ABI) Method(name string) (*Method, bool) {
+	for _, m := range a.Methods {
+		if m.Name == name {
+			return m, true
+		}
+	}
+	return nil, false
+}
+
+// canonicalType expands the aliases that are not allowed in signatures
+func canonicalType(t string) string {
+	switch t {
+	case "uint":
+		return "uint256"
+	case "int":
+		return "int256"
+	}
+	return t
+}
+
+// Call is a decoded contract call. Args holds the 32 byte word of every
+// input.
+type Call struct {
+	Method *Method
+	Args   [][]byte
+}
+
+// Decode reads the input data of a transaction. Only functions with static
+// arguments are supported, which is all the anchor contract uses.
+func (a *ABI) Decode(input []byte) (*Call, error) {
+	if len(input) < 4 {
+		return nil, fmt.Errorf("%w: %d bytes", ErrShortInput, len(input))
+	}
+	var sel [4]byte
+	copy(sel[:], input)
+	m, ok := a.Methods[sel]
+	if !ok {
+		return nil, fmt.Errorf("%w: selector %x", ErrUnknownMethod, sel)
+	}
+
+	data := input[4:]
+	if len(data) != 32*len(m.Inputs) {
+		return nil, fmt.Errorf("%w: %s has %d bytes of arguments, want %d", ErrInputSize, m.Name, len(data), 32*len(m.Inputs))
+	}
+
+	c := &Call{Method: m}
+	for i, in := range m.Inputs {
+		word := data[32*i : 32*(i+1)]
+		if err := checkWord(in.Type, word); err != nil {
+			return nil, fmt.Errorf("%s argument %s: %w", m.Name, in.Name, err)
+		}
+		c.Args = append(c.Args, word)
+	}
+	return c, nil
+}
+
+// checkWord makes sure a word is a valid encoding of the type
+func checkWord(typ string, word []byte) error {
+	// the number of bytes that carry the value, the others have to be zero
+	var size int
+	left := false
+	switch {
+	case typ == "address":
+		size = 20
+	case typ == "bool":
+		if !zero(word[:31]) || word[31] > 1 {
+			return fmt.Errorf("%w: bool %x", ErrBadValue, word)
+		}
+		return nil
+	case strings.HasPrefix(typ, "uint"):
+		bits, err := typeSize(typ[4:], 8, 256)
+		if err != nil {
+			return err
+		}
+		size = bits / 8
+	case strings.HasPrefix(typ, "int"):
+		bits, err := typeSize(typ[3:], 8, 256)
+		if err != nil {
+			return err
+		}
+		// the value is sign extended
+		pad := byte(0)
+		if word[32-bits/8]&0x80 != 0 {
+			pad = 0xff
+		}
+		for _, b := range word[:32-bits/8] {
+			if b != pad {
+				return fmt.Errorf("%w: %s %x", ErrBadValue, typ, word)
+			}
+		}
+		return nil
+	case strings.HasPrefix(typ, "bytes") && typ != "bytes":
+		n, err := typeSize(typ[5:], 1, 32)
+		if err != nil {
+			return err
+		}
+		size, left = n, true
+	default:
+		return fmt.Errorf("%w: %s", ErrUnsupported, typ)
+	}
+
+	pad := word[:32-size]
+	if left {
+		pad = word[size:]
+	}
+	if !zero(pad) {
+		return fmt.Errorf("%w: %s %x", ErrBadValue, typ, word)
+	}
+	return nil
+}
+
+// typeSize parses the size suffix of a type, a multiple of step up to max
+func typeSize(s string, step, max int) (int, error) {
+	if s == "" {
+		return max, nil
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil || n <= 0 || n > max || n%step != 0 {
+		return 0, fmt.Errorf("%w: size %q", ErrUnsupported, s)
+	}
+	return n, nil
+}
+
+func zero(b []byte) bool {
+	for _, c := range b {
+		if c != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Word returns the raw word of the named argument
+func (c *Call) Word(name string) ([]byte, error) {
+	for i, in := range c.Method.Inputs {
+		if in.Name == name {
+			return c.Args[i], nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s has no argument %q", ErrNoArgument, c.Method.Name, name)
+}
+
+// Uint returns the named argument as an unsigned number
+func (c *Call) Uint(name string) (*big.Int, error) {
+	w, err := c.Word(name)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(w), nil
+}
@@ -0,0 +1,126 @@
+package anchorabi
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+)
+
+// ErrNotAnchor is returned for calls of other contract functions
+var ErrNotAnchor = errors.New("not an anchor call")
+
+// DefaultABI describes the anchor function of the factom anchor contract
+const DefaultABI = `[{
+	"type": "function",
+	"name": "setAnchor",
+	"inputs": [
+		{"name": "blockNumber", "type": "uint256"},
+		{"name": "keyMR", "type": "uint256"}
+	],
+	"outputs": []
+}]`
+
+// Layout names the anchor function and the arguments that hold the anchored
+// window. MinHeight is optional, windows end at the anchored height.
+type Layout struct {
+	Method    string
+	MaxHeight string
+	MinHeight string
+	WindowMR  string
+}
+
+// DefaultLayout matches DefaultABI
+var DefaultLayout = Layout{
+	Method:    "setAnchor",
+	MaxHeight: "blockNumber",
+	WindowMR:  "keyMR",
+}
+
+// Anchor is the window of directory blocks an anchor call commits to.
+// DBHeightMin is -1 if the call does not include it.
+type Anchor struct {
+	DBHeightMin int64
+	DBHeightMax int64
+	WindowMR    [32]byte
+}
+
+// WindowMRString returns the window root in hex, the way factomd prints it
+func (a *Anchor) WindowMRString() string {
+	return hex.EncodeToString(a.WindowMR[:])
+}
+
+// Decoder reads anchors from the input of anchor contract transactions
+type Decoder struct {
+	abi    *ABI
+	layout Layout
+}
+
+// NewDecoder checks that the ABI has the function and arguments of the
+// layout
+func NewDecoder(abi []byte, layout Layout) (*Decoder, error) {
+	a, err := ParseABI(abi)
+	if err != nil {
+		return nil, err
+	}
+	m, ok := a.Method(layout.Method)
+	if !ok {
+		return nil, fmt.Errorf("abi: %w: %s", ErrUnknownMethod, layout.Method)
+	}
+
+	args := map[string]bool{}
+	for _, in := range m.Inputs {
+		args[in.Name] = true
+	}
+	for _, name := range []string{layout.MaxHeight, layout.MinHeight, layout.WindowMR} {
+		if name != "" && !args[name] {
+			return nil, fmt.Errorf("abi: %w: %s has no argument %q", ErrNoArgument, m.Name, name)
+		}
+	}
+	if layout.MaxHeight == "" || layout.WindowMR == "" {
+		return nil, fmt.Errorf("abi: the layout needs the height and window root arguments")
+	}
+
+	return &Decoder{abi: a, layout: layout}, nil
+}
+
+// Anchor decodes the input of an anchor transaction
+func (d *Decoder) Anchor(input []byte) (*Anchor, error) {
+	c, err := d.abi.Decode(input)
+	if err != nil {
+		return nil, err
+	}
+	if c.Method.Name != d.layout.Method {
+		return nil, fmt.Errorf("%w: %s", ErrNotAnchor, c.Method.Name)
+	}
+
+	a := &Anchor{DBHeightMin: -1}
+	if a.DBHeightMax, err = d.height(c, d.layout.MaxHeight); err != nil {
+		return nil, err
+	}
+	if d.layout.MinHeight != "" {
+		if a.DBHeightMin, err = d.height(c, d.layout.MinHeight); err != nil {
+			return nil, err
+		}
+		if a.DBHeightMin > a.DBHeightMax {
+			return nil, fmt.Errorf("%w: window %d to %d", ErrBadValue, a.DBHeightMin, a.DBHeightMax)
+		}
+	}
+
+	root, err := c.Word(d.layout.WindowMR)
+	if err != nil {
+		return nil, err
+	}
+	copy(a.WindowMR[:], root)
+	return a, nil
+}
+
+func (d *Decoder) height(c *Call, name string) (int64, error) {
+	n, err := c.Uint(name)
+	if err != nil {
+		return 0, err
+	}
+	if !n.IsInt64() {
+		return 0, fmt.Errorf("%w: height %s", ErrBadValue, n)
+	}
+	return n.Int64(), nil
+}
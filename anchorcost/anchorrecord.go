@@ -0,0 +1,50 @@
+package anchorcost
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// AnchorChainID is the factom chain that factomd writes an AnchorRecord
+// entry to for every bitcoin anchor
+const AnchorChainID = "df3ade9eec4b08d5379cc64270c30ea7315d8a8a1a69efe2b98a60ecdd69e604"
+
+// AnchorRecord is the content of an anchor chain entry. DBHeight and KeyMR
+// are the directory block that was anchored, RecordHeight the height the
+// record was written at.
+type AnchorRecord struct {
+	AnchorRecordVer int
+	DBHeight        uint32
+	KeyMR           string
+	RecordHeight    uint32
+	Bitcoin         *AnchorRecordBitcoin
+}
+
+// AnchorRecordBitcoin is where the anchor transaction was mined. TXID and
+// BlockHash are in the usual reversed byte order.
+type AnchorRecordBitcoin struct {
+	Address     string
+	TXID        string
+	BlockHeight int32
+	BlockHash   string
+	Offset      int32
+}
+
+// ParseAnchorRecord reads the content of an anchor chain entry. Version 1
+// records have their signature appended to the json, it is not checked.
+func ParseAnchorRecord(content []byte) (*AnchorRecord, error) {
+	content = bytes.TrimSpace(content)
+	if len(content) == 0 || content[0] != '{' {
+		return nil, fmt.Errorf("%w: not an anchor record", ErrMalformed)
+	}
+
+	rec := new(AnchorRecord)
+	if err := json.NewDecoder(bytes.NewReader(content)).Decode(rec); err != nil {
+		return nil, fmt.Errorf("%w: anchor record: %v", ErrMalformed, err)
+	}
+	if rec.KeyMR == "" {
+		return nil, fmt.Errorf("%w: anchor record without keymr", ErrMalformed)
+	}
+	return rec, nil
+}
@@ -0,0 +1,150 @@
+// Package anchorscript decodes the OP_RETURN outputs that factomd uses to
+// anchor directory blocks in bitcoin. The payload is the marker "Fa", the
+// directory block height as 6 bytes big endian and the 32 byte KeyMR.
+package anchorscript
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+)
+
+// script opcodes
+const (
+	OpReturn    = 0x6a
+	OpPushData1 = 0x4c
+	OpPushData2 = 0x4d
+	OpPushData4 = 0x4e
+)
+
+// Marker starts every anchor payload
+var Marker = []byte("Fa")
+
+// PayloadSize is the length of an anchor payload including the marker
+const PayloadSize = 40
+
+var (
+	ErrNotOpReturn = errors.New("not an OP_RETURN script")
+	ErrNoData      = errors.New("OP_RETURN without data")
+	ErrNotPush     = errors.New("not a push opcode")
+	ErrTruncated   = errors.New("truncated push")
+	ErrTrailing    = errors.New("trailing bytes after push")
+	ErrNotAnchor   = errors.New("not a factom anchor")
+	ErrPayloadSize = errors.New("wrong anchor payload size")
+	ErrNoAnchor    = errors.New("no anchor output")
+	ErrMultiple    = errors.New("more than one anchor output")
+)
+
+// Anchor is a decoded anchor payload
+type Anchor struct {
+	Height int64
+	KeyMR  [32]byte
+}
+
+// KeyMRString returns the KeyMR in hex, the way factomd prints it
+func (a *Anchor) KeyMRString() string {
+	return hex.EncodeToString(a.KeyMR[:])
+}
+
+// PushData returns the data of an OP_RETURN script that has a single push
+// after the OP_RETURN
+func PushData(script []byte) ([]byte, error) {
+	if len(script) == 0 || script[0] != OpReturn {
+		return nil, ErrNotOpReturn
+	}
+	if len(script) == 1 {
+		return nil, ErrNoData
+	}
+
+	op := script[1]
+	rest := script[2:]
+	var n uint64
+	switch {
+	case op < OpPushData1:
+		n = uint64(op)
+	case op == OpPushData1:
+		if len(rest) < 1 {
+			return nil, fmt.Errorf("%w: OP_PUSHDATA1 without length", ErrTruncated)
+		}
+		n, rest = uint64(rest[0]), rest[1:]
+	case op == OpPushData2:
+		if len(rest) < 2 {
+			return nil, fmt.Errorf("%w: OP_PUSHDATA2 without length", ErrTruncated)
+		}
+		n, rest = uint64(binary.LittleEndian.Uint16(rest)), rest[2:]
+	case op == OpPushData4:
+		if len(rest) < 4 {
+			return nil, fmt.Errorf("%w: OP_PUSHDATA4 without length", ErrTruncated)
+		}
+		n, rest = uint64(binary.LittleEndian.Uint32(rest)), rest[4:]
+	default:
+		return nil, fmt.Errorf("%w: opcode 0x%02x", ErrNotPush, op)
+	}
+
+	if uint64(len(rest)) < n {
+		return nil, fmt.Errorf("%w: %d of %d bytes", ErrTruncated, len(rest), n)
+	}
+	if uint64(len(rest)) > n {
+		return nil, fmt.Errorf("%w: %d bytes", ErrTrailing, uint64(len(rest))-n)
+	}
+	return rest, nil
+}
+
+// Decode reads the anchor of an output script. Scripts that are not
+// OP_RETURN return ErrNotOpReturn and data without the marker ErrNotAnchor.
+func Decode(script []byte) (*Anchor, error) {
+	data, err := PushData(script)
+	if err != nil {
+		return nil, err
+	}
+	return DecodePayload(data)
+}
+
+// DecodePayload reads an anchor payload, the data pushed by the script
+func DecodePayload(data []byte) (*Anchor, error) {
+	if !bytes.HasPrefix(data, Marker) {
+		return nil, ErrNotAnchor
+	}
+	if len(data) != PayloadSize {
+		return nil, fmt.Errorf("%w: %d bytes, want %d", ErrPayloadSize, len(data), PayloadSize)
+	}
+
+	a := new(Anchor)
+	a.Height = int64(binary.BigEndian.Uint64(append([]byte{0, 0}, data[2:8]...)))
+	copy(a.KeyMR[:], data[8:])
+	return a, nil
+}
+
+// Find returns the anchor of a transaction and the index of its output. If
+// no output is an anchor, the error is ErrNoAnchor or the error of an
+// OP_RETURN output that could not be read.
+func Find(scripts [][]byte) (*Anchor, int, error) {
+	var found *Anchor
+	index := -1
+	var broken error
+	for i, script := range scripts {
+		a, err := Decode(script)
+		switch {
+		case errors.Is(err, ErrNotOpReturn) || errors.Is(err, ErrNotAnchor):
+			continue
+		case err != nil:
+			if broken == nil {
+				broken = fmt.Errorf("output %d: %w", i, err)
+			}
+			continue
+		case found != nil:
+			return nil, -1, fmt.Errorf("%w: outputs %d and %d", ErrMultiple, index, i)
+		}
+		found, index = a, i
+	}
+
+	if found != nil {
+		return found, index, nil
+	}
+	if broken != nil {
+		return nil, -1, broken
+	}
+	return nil, -1, ErrNoAnchor
+}
@@ -0,0 +1,120 @@
+package anchorscript
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+)
+
+// payload builds an anchor payload for height with a KeyMR of 32 bytes b
+func payload(height int64, b byte) []byte {
+	p := append([]byte{}, Marker...)
+	var h [8]byte
+	binary.BigEndian.PutUint64(h[:], uint64(height))
+	p = append(p, h[2:]...)
+	return append(p, bytes.Repeat([]byte{b}, 32)...)
+}
+
+func concat(parts ...[]byte) []byte {
+	var res []byte
+	for _, p := range parts {
+		res = append(res, p...)
+	}
+	return res
+}
+
+func TestDecode(t *testing.T) {
+	p := payload(1234, 0xab)
+	tests := []struct {
+		name   string
+		script []byte
+		err    error
+	}{
+		{"direct push", concat([]byte{OpReturn, PayloadSize}, p), nil},
+		{"pushdata1", concat([]byte{OpReturn, OpPushData1, PayloadSize}, p), nil},
+		{"pushdata2", concat([]byte{OpReturn, OpPushData2, PayloadSize, 0}, p), nil},
+		{"pushdata4", concat([]byte{OpReturn, OpPushData4, PayloadSize, 0, 0, 0}, p), nil},
+		{"empty", nil, ErrNotOpReturn},
+		{"not op_return", concat([]byte{0x76, PayloadSize}, p), ErrNotOpReturn},
+		{"no data", []byte{OpReturn}, ErrNoData},
+		{"not a push", []byte{OpReturn, 0x51}, ErrNotPush},
+		{"truncated direct push", concat([]byte{OpReturn, PayloadSize + 1}, p), ErrTruncated},
+		{"truncated pushdata1 length", []byte{OpReturn, OpPushData1}, ErrTruncated},
+		{"truncated pushdata2 length", []byte{OpReturn, OpPushData2, 1}, ErrTruncated},
+		{"truncated pushdata4 length", []byte{OpReturn, OpPushData4, 1, 0}, ErrTruncated},
+		{"truncated pushdata2 data", concat([]byte{OpReturn, OpPushData2, PayloadSize + 1, 0}, p), ErrTruncated},
+		{"trailing bytes", concat([]byte{OpReturn, PayloadSize - 1}, p), ErrTrailing},
+		{"no marker", concat([]byte{OpReturn, PayloadSize}, []byte("Fb"), p[2:]), ErrNotAnchor},
+		{"empty push", []byte{OpReturn, 0}, ErrNotAnchor},
+		{"short payload", concat([]byte{OpReturn, PayloadSize - 1}, p[:PayloadSize-1]), ErrPayloadSize},
+		{"long payload", concat([]byte{OpReturn, PayloadSize + 1}, p, []byte{0}), ErrPayloadSize},
+	}
+
+	for _, tt := range tests {
+		a, err := Decode(tt.script)
+		if !errors.Is(err, tt.err) {
+			t.Errorf("%s: got error %v, want %v", tt.name, err, tt.err)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if a.Height != 1234 || a.KeyMRString() != strings.Repeat("ab", 32) {
+			t.Errorf("%s: got height %d keymr %s", tt.name, a.Height, a.KeyMRString())
+		}
+	}
+}
+
+func TestDecodeBaseline(t *testing.T) {
+	// the second output of an anchor transaction of the address
+	script, err := hex.DecodeString("6a28466100000003eebbbe5a5e36d029fe5dad88394dd53539df09465fba9c07140630162ba47aaa37ba")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a, err := Decode(script)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.Height != 257723 {
+		t.Errorf("height %d, want 257723", a.Height)
+	}
+	if want := "be5a5e36d029fe5dad88394dd53539df09465fba9c07140630162ba47aaa37ba"; a.KeyMRString() != want {
+		t.Errorf("keymr %s, want %s", a.KeyMRString(), want)
+	}
+}
+
+func TestFind(t *testing.T) {
+	anchor := concat([]byte{OpReturn, PayloadSize}, payload(5, 1))
+	p2pkh, _ := hex.DecodeString("76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac")
+	other := []byte{OpReturn, 4, 'o', 'm', 'n', 'i'}
+
+	tests := []struct {
+		name    string
+		scripts [][]byte
+		index   int
+		err     error
+	}{
+		{"last output", [][]byte{p2pkh, anchor}, 1, nil},
+		{"first output", [][]byte{anchor, p2pkh}, 0, nil},
+		{"between other op_returns", [][]byte{other, anchor, p2pkh}, 1, nil},
+		{"two anchors", [][]byte{anchor, p2pkh, anchor}, -1, ErrMultiple},
+		{"no anchor", [][]byte{p2pkh, other}, -1, ErrNoAnchor},
+		{"no outputs", nil, -1, ErrNoAnchor},
+		{"broken op_return", [][]byte{p2pkh, {OpReturn, PayloadSize}}, -1, ErrTruncated},
+	}
+
+	for _, tt := range tests {
+		a, i, err := Find(tt.scripts)
+		if !errors.Is(err, tt.err) || i != tt.index {
+			t.Errorf("%s: got index %d error %v, want %d %v", tt.name, i, err, tt.index, tt.err)
+			continue
+		}
+		if err == nil && a.Height != 5 {
+			t.Errorf("%s: height %d, want 5", tt.name, a.Height)
+		}
+	}
+}
@@ -0,0 +1,38 @@
+//go:build go1.18
+// +build go1.18
+
+package anchorscript
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+)
+
+func FuzzDecode(f *testing.F) {
+	f.Add([]byte{OpReturn, PayloadSize}, int64(257723), bytes.Repeat([]byte{0xab}, 32))
+	f.Add([]byte{OpReturn, OpPushData2, PayloadSize, 0}, int64(0), []byte{})
+	f.Add([]byte{0x76, 0xa9}, int64(1<<47), []byte{1, 2, 3})
+
+	f.Fuzz(func(t *testing.T, script []byte, height int64, keymr []byte) {
+		// arbitrary scripts must not panic
+		Decode(script)
+
+		// valid payloads round trip
+		height &= 1<<48 - 1
+		var mr [32]byte
+		copy(mr[:], keymr)
+		p := append([]byte{}, Marker...)
+		var h [8]byte
+		binary.BigEndian.PutUint64(h[:], uint64(height))
+		p = append(append(p, h[2:]...), mr[:]...)
+
+		a, err := Decode(append([]byte{OpReturn, PayloadSize}, p...))
+		if err != nil {
+			t.Fatalf("valid payload: %v", err)
+		}
+		if a.Height != height || a.KeyMR != mr {
+			t.Fatalf("got %d %x, want %d %x", a.Height, a.KeyMR, height, mr)
+		}
+	})
+}
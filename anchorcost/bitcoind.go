@@ -0,0 +1,203 @@
+package anchorcost
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Bitcoind is a BTCProvider for the json-rpc api of a bitcoin core node.
+// Looking up arbitrary transactions requires the node to run with -txindex.
+// Address histories are read from the node's wallet, so the address has to
+// be imported as watch-only.
+type Bitcoind struct {
+	rpc *rpcClient
+}
+
+var _ BTCProvider = (*Bitcoind)(nil)
+var _ btcTimer = (*Bitcoind)(nil)
+
+func NewBitcoind(url, user, pass string) *Bitcoind {
+	b := new(Bitcoind)
+	b.rpc = &rpcClient{url: url, user: user, pass: pass, version: "1.0", retry: DefaultRetry, bucket: "btc/bitcoind"}
+	return b
+}
+
+// SetRetry replaces the retry policy of failed requests
+func (b *Bitcoind) SetRetry(r RetryPolicy) {
+	b.rpc.retry = r
+}
+
+// SetCache stores confirmed transactions and block headers in c
+func (b *Bitcoind) SetCache(c *Cache) {
+	b.rpc.cache = c
+}
+
+type bitcoindTx struct {
+	TxID      string          `json:"txid"`
+	BlockHash string          `json:"blockhash"`
+	Vin       []bitcoindInput `json:"vin"`
+	Vout      []bitcoindOut   `json:"vout"`
+}
+
+type bitcoindInput struct {
+	Coinbase string `json:"coinbase"`
+	TxID     string `json:"txid"`
+	Vout     int    `json:"vout"`
+}
+
+type bitcoindOut struct {
+	Value        json.Number `json:"value"`
+	ScriptPubKey struct {
+		Hex string `json:"hex"`
+	} `json:"scriptPubKey"`
+}
+
+func (o bitcoindOut) convert() (BTCOutput, error) {
+	value, err := btcToSatoshi(o.Value)
+	if err != nil {
+		return BTCOutput{}, err
+	}
+	script, err := hex.DecodeString(o.ScriptPubKey.Hex)
+	if err != nil {
+		return BTCOutput{}, err
+	}
+	return BTCOutput{Value: value, Script: script}, nil
+}
+
+func (b *Bitcoind) rawTx(txid string) (*bitcoindTx, error) {
+	res := new(bitcoindTx)
+	keep := func(raw []byte) bool {
+		var tx bitcoindTx
+		return json.Unmarshal(raw, &tx) == nil && tx.BlockHash != ""
+	}
+	if err := b.rpc.cachedCall("tx/"+txid, keep, "getrawtransaction", res, txid, true); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (b *Bitcoind) blockTime(hash string) (time.Time, error) {
+	var header struct {
+		Time int64 `json:"time"`
+	}
+	if err := b.rpc.cachedCall("header/"+hash, rpcObject, "getblockheader", &header, hash); err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(header.Time, 0), nil
+}
+
+// TxTime returns the time of the block the transaction was confirmed in,
+// without looking up its inputs
+func (b *Bitcoind) TxTime(txid string) (time.Time, error) {
+	raw, err := b.rawTx(txid)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if raw.BlockHash == "" {
+		return time.Time{}, fmt.Errorf("%s: transaction %w", txid, ErrPending)
+	}
+	return b.blockTime(raw.BlockHash)
+}
+
+// Tx looks up the transaction along with the transactions of every input
+func (b *Bitcoind) Tx(txid string) (*BTCTx, error) {
+	raw, err := b.rawTx(txid)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := new(BTCTx)
+	tx.Hash = raw.TxID
+	if raw.BlockHash != "" {
+		if tx.Time, err = b.blockTime(raw.BlockHash); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, in := range raw.Vin {
+		if in.Coinbase != "" {
+			continue
+		}
+
+		prev, err := b.rawTx(in.TxID)
+		if err != nil {
+			return nil, err
+		}
+		if in.Vout >= len(prev.Vout) {
+			return nil, fmt.Errorf("%s: input %s:%d does not exist", txid, in.TxID, in.Vout)
+		}
+
+		out, err := prev.Vout[in.Vout].convert()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", in.TxID, err)
+		}
+		tx.Inputs = append(tx.Inputs, out)
+	}
+
+	for _, o := range raw.Vout {
+		out, err := o.convert()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", txid, err)
+		}
+		tx.Outputs = append(tx.Outputs, out)
+	}
+
+	return tx, nil
+}
+
+// bitcoindPage is the number of wallet entries to request at a time
+const bitcoindPage = 50
+
+type bitcoindWalletTx struct {
+	Address string `json:"address"`
+	TxID    string `json:"txid"`
+}
+
+// AddressTxs reads the history of a watch-only address from the node's
+// wallet, 50 wallet entries at a time. The cursor is the number of entries
+// to skip.
+func (b *Bitcoind) AddressTxs(addr, cursor string) ([]*BTCTx, string, error) {
+	var skip int64
+	if cursor != "" {
+		var err error
+		if skip, err = strconv.ParseInt(cursor, 10, 64); err != nil {
+			return nil, "", fmt.Errorf("invalid offset %q", cursor)
+		}
+	}
+
+	var entries []bitcoindWalletTx
+	if err := b.rpc.call("listtransactions", &entries, "*", bitcoindPage, skip, true); err != nil {
+		return nil, "", err
+	}
+
+	// listtransactions returns the oldest entry of the page first
+	seen := make(map[string]bool)
+	var txs []*BTCTx
+	for i := len(entries) - 1; i >= 0; i-- {
+		e := entries[i]
+		if e.Address != addr || seen[e.TxID] {
+			continue
+		}
+		seen[e.TxID] = true
+
+		tx, err := b.Tx(e.TxID)
+		if err != nil {
+			return nil, "", err
+		}
+		txs = append(txs, tx)
+	}
+
+	if len(entries) < bitcoindPage {
+		return txs, "", nil
+	}
+	return txs, strconv.FormatInt(skip+int64(len(entries)), 10), nil
+}
+
+// btcToSatoshi converts a decimal bitcoin amount to satoshi without going
+// through a float
+func btcToSatoshi(n json.Number) (uint64, error) {
+	return ParseSatoshi(n.String())
+}
@@ -0,0 +1,155 @@
+package anchorcost
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// bitcoindStandIn answers the json-rpc calls of the Bitcoind provider from
+// canned results, the way bitcoin core does including its error statuses
+func bitcoindStandIn(t *testing.T, results map[string]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if user, pass, ok := r.BasicAuth(); !ok || user != "user" || pass != "pass" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		var req rpcRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("bad request: %v", err)
+			return
+		}
+		key := req.Method
+		if len(req.Params) > 0 {
+			key += " " + fmt.Sprint(req.Params[0])
+		}
+
+		res, ok := results[key]
+		if !ok {
+			// bitcoind sends rpc errors with status 500
+			w.WriteHeader(http.StatusInternalServerError)
+			fmt.Fprintf(w, `{"result":null,"error":{"code":-5,"message":"No such mempool or blockchain transaction"},"id":%d}`, req.ID)
+			return
+		}
+		fmt.Fprintf(w, `{"result":%s,"error":null,"id":%d}`, res, req.ID)
+	}))
+}
+
+func TestBitcoindTx(t *testing.T) {
+	srv := bitcoindStandIn(t, map[string]string{
+		"getrawtransaction anchor": `{
+			"txid": "anchor",
+			"blockhash": "block",
+			"vin": [{"txid": "prev", "vout": 1}],
+			"vout": [
+				{"value": 0.00008155, "scriptPubKey": {"hex": "76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"}},
+				{"value": 0, "scriptPubKey": {"hex": "6a28466100000003eebbbe5a5e36d029fe5dad88394dd53539df09465fba9c07140630162ba47aaa37ba"}}
+			]
+		}`,
+		"getrawtransaction prev": `{
+			"txid": "prev",
+			"blockhash": "older",
+			"vin": [{"coinbase": "04ffff001d"}],
+			"vout": [
+				{"value": 50.0, "scriptPubKey": {"hex": "51"}},
+				{"value": 0.0001, "scriptPubKey": {"hex": "51"}}
+			]
+		}`,
+		"getblockheader block": `{"hash": "block", "time": 1598918400}`,
+	})
+	defer srv.Close()
+
+	b := NewBitcoind(srv.URL, "user", "pass")
+	tx, err := b.Tx("anchor")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := time.Unix(1598918400, 0); !tx.Time.Equal(want) {
+		t.Errorf("time %v, want %v", tx.Time, want)
+	}
+	if len(tx.Inputs) != 1 || tx.Inputs[0].Value != 10000 {
+		t.Fatalf("inputs %+v, want the 10000 satoshi output of prev", tx.Inputs)
+	}
+	if len(tx.Outputs) != 2 || !tx.Outputs[1].IsOpReturn() {
+		t.Fatalf("outputs %+v", tx.Outputs)
+	}
+
+	bd, err := tx.Breakdown()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bd.Fee != 1845 || bd.Change != 8155 {
+		t.Errorf("fee %d change %d, want 1845 8155", bd.Fee, bd.Change)
+	}
+}
+
+func TestBitcoindTxTime(t *testing.T) {
+	// no prevouts, TxTime must not look them up
+	srv := bitcoindStandIn(t, map[string]string{
+		"getrawtransaction anchor":  `{"txid": "anchor", "blockhash": "block", "vin": [{"txid": "prev", "vout": 1}], "vout": []}`,
+		"getrawtransaction mempool": `{"txid": "mempool", "vin": [], "vout": []}`,
+		"getblockheader block":      `{"hash": "block", "time": 1598918400}`,
+	})
+	defer srv.Close()
+
+	b := NewBitcoind(srv.URL, "user", "pass")
+	tt, err := b.TxTime("anchor")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := time.Unix(1598918400, 0); !tt.Equal(want) {
+		t.Errorf("time %v, want %v", tt, want)
+	}
+
+	if _, err := b.TxTime("mempool"); !errors.Is(err, ErrPending) {
+		t.Errorf("got %v, want ErrPending", err)
+	}
+}
+
+func TestBitcoindNotFound(t *testing.T) {
+	srv := bitcoindStandIn(t, map[string]string{})
+	defer srv.Close()
+
+	b := NewBitcoind(srv.URL, "user", "pass")
+	_, err := b.Tx("missing")
+	if !errors.Is(err, ErrNotFound) {
+		t.Fatalf("got %v, want ErrNotFound", err)
+	}
+	if Retryable(err) {
+		t.Errorf("%v should not be retried", err)
+	}
+}
+
+func TestBTCToSatoshi(t *testing.T) {
+	tests := []struct {
+		btc string
+		sat uint64
+		err bool
+	}{
+		{"0.00001845", 1845, false},
+		{"0.0001", 10000, false},
+		{"0", 0, false},
+		{"1", 1e8, false},
+		{"50.0", 50e8, false},
+		{"21000000.00000000", 21e14, false},
+		{"0.000000001", 0, true},
+		{"1e-8", 0, true},
+	}
+
+	for _, tt := range tests {
+		sat, err := btcToSatoshi(json.Number(tt.btc))
+		if (err != nil) != tt.err {
+			t.Errorf("%s: error %v", tt.btc, err)
+			continue
+		}
+		if !tt.err && sat != tt.sat {
+			t.Errorf("%s: got %d, want %d", tt.btc, sat, tt.sat)
+		}
+	}
+}
@@ -0,0 +1,170 @@
+package anchorcost
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/ratelimit"
+)
+
+const BTC_URL = "https://blockchain.info"
+const BTC_LIMIT = 5
+
+// BlockchainInfo is a rate limited BTCProvider for the blockchain.info api
+type BlockchainInfo struct {
+	url   string
+	limit ratelimit.Limiter
+	retry RetryPolicy
+	cache *Cache
+}
+
+var _ BTCProvider = (*BlockchainInfo)(nil)
+
+// NewBlockchainInfo creates a client for the api at url, usually BTC_URL,
+// that makes at most limit requests per second. A limit of 0 is unlimited.
+func NewBlockchainInfo(url string, limit int) *BlockchainInfo {
+	b := new(BlockchainInfo)
+	b.url = strings.TrimSuffix(url, "/")
+	b.limit = newLimiter(limit)
+	b.retry = DefaultRetry
+	return b
+}
+
+// SetRetry replaces the retry policy of failed requests
+func (b *BlockchainInfo) SetRetry(r RetryPolicy) {
+	b.retry = r
+}
+
+// SetCache stores confirmed transactions in c
+func (b *BlockchainInfo) SetCache(c *Cache) {
+	b.cache = c
+}
+
+func (b *BlockchainInfo) call(method, hash string) ([]byte, error) {
+	url := fmt.Sprintf("%s/%s/%s", b.url, method, hash)
+
+	var body []byte
+	err := b.retry.Do(func() error {
+		b.limit.Take()
+		var err error
+		body, err = httpGet(url)
+		return err
+	})
+	return body, err
+}
+
+type bciTx struct {
+	Hash        string     `json:"hash"`
+	BlockHeight *int64     `json:"block_height"`
+	Time        int64      `json:"time"`
+	Fee         uint64     `json:"fee"`
+	Inputs      []bciInput `json:"inputs"`
+	Out         []bciOut   `json:"out"`
+}
+
+type bciInput struct {
+	PrevOut bciOut `json:"prev_out"`
+}
+
+type bciOut struct {
+	Value  uint64 `json:"value"`
+	Script string `json:"script"`
+}
+
+func (o bciOut) convert() (BTCOutput, error) {
+	script, err := hex.DecodeString(o.Script)
+	if err != nil {
+		return BTCOutput{}, err
+	}
+	return BTCOutput{Value: o.Value, Script: script}, nil
+}
+
+func (t *bciTx) convert() (*BTCTx, error) {
+	tx := new(BTCTx)
+	tx.Hash = t.Hash
+	tx.Time = time.Unix(t.Time, 0)
+	tx.ReportedFee = t.Fee
+	for _, in := range t.Inputs {
+		out, err := in.PrevOut.convert()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", t.Hash, err)
+		}
+		tx.Inputs = append(tx.Inputs, out)
+	}
+	for _, o := range t.Out {
+		out, err := o.convert()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", t.Hash, err)
+		}
+		tx.Outputs = append(tx.Outputs, out)
+	}
+	return tx, nil
+}
+
+func (b *BlockchainInfo) Tx(txid string) (*BTCTx, error) {
+	body, err := b.cache.cached("btc/blockchain", "tx/"+txid, func() ([]byte, error) {
+		return b.call("rawtx", txid)
+	}, func(data []byte) bool {
+		var tx bciTx
+		return json.Unmarshal(data, &tx) == nil && tx.Hash != "" && tx.BlockHeight != nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := new(bciTx)
+	if err := decode(body, res); err != nil {
+		return nil, err
+	}
+	return res.convert()
+}
+
+type bciAddress struct {
+	NTx int64    `json:"n_tx"`
+	TXs []*bciTx `json:"txs"`
+}
+
+// AddressTxs returns up to 50 transactions of an address. The cursor is the
+// offset into the address history.
+func (b *BlockchainInfo) AddressTxs(addr, cursor string) ([]*BTCTx, string, error) {
+	var offset int64
+	if cursor != "" {
+		var err error
+		if offset, err = strconv.ParseInt(cursor, 10, 64); err != nil {
+			return nil, "", fmt.Errorf("invalid offset %q", cursor)
+		}
+	}
+
+	if err := b.cache.live("address " + addr); err != nil {
+		return nil, "", err
+	}
+
+	body, err := b.call("rawaddr", fmt.Sprintf("%s?offset=%d&limit=50", addr, offset))
+	if err != nil {
+		return nil, "", err
+	}
+
+	res := bciAddress{}
+	if err := decode(body, &res); err != nil {
+		return nil, "", err
+	}
+
+	var txs []*BTCTx
+	for _, t := range res.TXs {
+		tx, err := t.convert()
+		if err != nil {
+			return nil, "", err
+		}
+		txs = append(txs, tx)
+	}
+
+	next := offset + int64(len(txs))
+	if len(txs) == 0 || next >= res.NTx {
+		return txs, "", nil
+	}
+	return txs, strconv.FormatInt(next, 10), nil
+}
@@ -0,0 +1,105 @@
+package anchorcost
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// BTCTx is a bitcoin transaction. Inputs contains the outputs that were
+// spent by the transaction. ReportedFee is the fee according to the
+// provider, or zero if the provider does not report fees.
+type BTCTx struct {
+	Hash        string
+	Time        time.Time
+	Inputs      []BTCOutput
+	Outputs     []BTCOutput
+	ReportedFee uint64
+}
+
+// BTCOutput is a single transaction output
+type BTCOutput struct {
+	Value  uint64
+	Script []byte
+}
+
+// IsOpReturn checks if the output is an unspendable data carrier
+func (o BTCOutput) IsOpReturn() bool {
+	return len(o.Script) > 0 && o.Script[0] == 0x6a
+}
+
+// BTCBreakdown shows where the inputs of a transaction went, in satoshi.
+// Change is the value of all outputs that are not OP_RETURN.
+type BTCBreakdown struct {
+	Inputs   uint64
+	Change   uint64
+	OpReturn uint64
+	Fee      uint64
+}
+
+// Breakdown calculates the fee as the sum of all inputs minus the sum of all
+// outputs. If the provider reported a fee, it has to match.
+func (tx *BTCTx) Breakdown() (*BTCBreakdown, error) {
+	b := new(BTCBreakdown)
+	for _, in := range tx.Inputs {
+		b.Inputs += in.Value
+	}
+	for _, out := range tx.Outputs {
+		if out.IsOpReturn() {
+			b.OpReturn += out.Value
+		} else {
+			b.Change += out.Value
+		}
+	}
+
+	spent := b.Change + b.OpReturn
+	if spent > b.Inputs {
+		return nil, fmt.Errorf("%s: outputs (%d) exceed inputs (%d)", tx.Hash, spent, b.Inputs)
+	}
+	b.Fee = b.Inputs - spent
+
+	if tx.ReportedFee != 0 && tx.ReportedFee != b.Fee {
+		return nil, fmt.Errorf("%s: calculated fee %d does not match reported fee %d", tx.Hash, b.Fee, tx.ReportedFee)
+	}
+	return b, nil
+}
+
+// Fee returns the fee paid by the transaction in satoshi
+func (tx *BTCTx) Fee() (uint64, error) {
+	b, err := tx.Breakdown()
+	if err != nil {
+		return 0, err
+	}
+	return b.Fee, nil
+}
+
+// BTCFee returns the fee breakdown of a bitcoin transaction
+func BTCFee(p BTCProvider, txid string) (*BTCBreakdown, error) {
+	tx, err := p.Tx(txid)
+	if err != nil {
+		return nil, err
+	}
+	return tx.Breakdown()
+}
+
+// FormatSatoshi formats an amount of satoshi as BTC
+func FormatSatoshi(sat uint64) string {
+	return fmt.Sprintf("%d.%08d", sat/1e8, sat%1e8)
+}
+
+// ParseSatoshi parses a BTC amount with up to 8 decimals into satoshi
+// without going through a float
+func ParseSatoshi(btc string) (uint64, error) {
+	btc = strings.TrimSpace(btc)
+	whole, frac := btc, ""
+	if i := strings.IndexByte(btc, '.'); i >= 0 {
+		whole, frac = btc[:i], btc[i+1:]
+	}
+	if len(frac) > 8 {
+		return 0, fmt.Errorf("invalid btc amount %q", btc)
+	}
+	frac += strings.Repeat("0", 8-len(frac))
+
+	return strconv.ParseUint(whole+frac, 10, 64)
+}
@@ -0,0 +1,110 @@
+package anchorcost
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/boltdb/bolt"
+)
+
+// ErrCacheMiss is returned in offline mode for data that is not cached
+var ErrCacheMiss = errors.New("not in cache")
+
+// Cache stores raw provider responses on disk. Only data that can no longer
+// change, like confirmed transactions and blocks, is stored. Each provider
+// keeps its responses in its own bucket since the formats differ.
+//
+// A nil *Cache is valid and always fetches.
+type Cache struct {
+	db      *bolt.DB
+	offline bool
+}
+
+// OpenCache opens or creates a cache file. In offline mode, every lookup
+// that is not cached fails with ErrCacheMiss instead of using the network.
+func OpenCache(path string, offline bool) (*Cache, error) {
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("unable to open cache %s: %v", path, err)
+	}
+	c := new(Cache)
+	c.db = db
+	c.offline = offline
+	return c, nil
+}
+
+func (c *Cache) Close() error {
+	if c == nil {
+		return nil
+	}
+	return c.db.Close()
+}
+
+func (c *Cache) get(bucket, key string) []byte {
+	if c == nil {
+		return nil
+	}
+
+	var data []byte
+	c.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(bucket))
+		if b == nil {
+			return nil
+		}
+		if v := b.Get([]byte(key)); v != nil {
+			data = append([]byte(nil), v...)
+		}
+		return nil
+	})
+	return data
+}
+
+func (c *Cache) put(bucket, key string, data []byte) error {
+	if c == nil {
+		return nil
+	}
+	return c.db.Update(func(tx *bolt.Tx) error {
+		b, err := tx.CreateBucketIfNotExists([]byte(bucket))
+		if err != nil {
+			return err
+		}
+		return b.Put([]byte(key), data)
+	})
+}
+
+// cached returns the response stored under bucket and key or fetches it.
+// Fetched responses are only stored if keep returns true.
+func (c *Cache) cached(bucket, key string, fetch func() ([]byte, error), keep func([]byte) bool) ([]byte, error) {
+	if c == nil {
+		return fetch()
+	}
+
+	if data := c.get(bucket, key); data != nil {
+		return data, nil
+	}
+
+	if c.offline {
+		return nil, fmt.Errorf("%s %s: %w", bucket, key, ErrCacheMiss)
+	}
+
+	data, err := fetch()
+	if err != nil {
+		return nil, err
+	}
+
+	if keep(data) {
+		if err := c.put(bucket, key, data); err != nil {
+			return nil, err
+		}
+	}
+	return data, nil
+}
+
+// live is for requests that are never cached, they fail in offline mode
+func (c *Cache) live(what string) error {
+	if c != nil && c.offline {
+		return fmt.Errorf("%s: %w", what, ErrCacheMiss)
+	}
+	return nil
+}
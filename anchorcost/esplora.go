@@ -0,0 +1,189 @@
+package anchorcost
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Esplora is a BTCProvider for the rest api of an esplora or electrs server
+type Esplora struct {
+	url   string
+	retry RetryPolicy
+	cache *Cache
+}
+
+var _ BTCProvider = (*Esplora)(nil)
+
+func NewEsplora(url string) *Esplora {
+	e := new(Esplora)
+	e.url = strings.TrimSuffix(url, "/")
+	e.retry = DefaultRetry
+	return e
+}
+
+// SetRetry replaces the retry policy of failed requests
+func (e *Esplora) SetRetry(r RetryPolicy) {
+	e.retry = r
+}
+
+// SetCache stores confirmed transactions in c
+func (e *Esplora) SetCache(c *Cache) {
+	e.cache = c
+}
+
+func (e *Esplora) get(path string) ([]byte, error) {
+	var body []byte
+	err := e.retry.Do(func() error {
+		var err error
+		body, err = httpGet(e.url + path)
+		return err
+	})
+	return body, err
+}
+
+// call decodes the response of path. If cache is set, confirmed responses
+// are cached.
+func (e *Esplora) call(path string, result interface{}, cache bool) error {
+	fetch := func() ([]byte, error) { return e.get(path) }
+
+	var body []byte
+	var err error
+	if cache {
+		body, err = e.cache.cached("btc/esplora", path, fetch, esploraConfirmed)
+	} else if err = e.cache.live(path); err == nil {
+		body, err = fetch()
+	}
+	if err != nil {
+		return err
+	}
+
+	return decode(body, result)
+}
+
+// esploraConfirmed checks if a transaction or status response is confirmed
+func esploraConfirmed(data []byte) bool {
+	var res struct {
+		Confirmed bool           `json:"confirmed"`
+		Status    *esploraStatus `json:"status"`
+	}
+	if err := json.Unmarshal(data, &res); err != nil {
+		return false
+	}
+	return res.Confirmed || (res.Status != nil && res.Status.Confirmed)
+}
+
+type esploraStatus struct {
+	Confirmed   bool   `json:"confirmed"`
+	BlockHeight int64  `json:"block_height"`
+	BlockHash   string `json:"block_hash"`
+	BlockTime   int64  `json:"block_time"`
+}
+
+type esploraTx struct {
+	TxID   string         `json:"txid"`
+	Vin    []esploraInput `json:"vin"`
+	Vout   []esploraOut   `json:"vout"`
+	Fee    uint64         `json:"fee"`
+	Status esploraStatus  `json:"status"`
+}
+
+type esploraInput struct {
+	IsCoinbase bool        `json:"is_coinbase"`
+	PrevOut    *esploraOut `json:"prevout"`
+}
+
+type esploraOut struct {
+	ScriptPubKey string `json:"scriptpubkey"`
+	Value        uint64 `json:"value"`
+}
+
+func (o *esploraOut) convert() (BTCOutput, error) {
+	script, err := hex.DecodeString(o.ScriptPubKey)
+	if err != nil {
+		return BTCOutput{}, err
+	}
+	return BTCOutput{Value: o.Value, Script: script}, nil
+}
+
+func (t *esploraTx) convert() (*BTCTx, error) {
+	tx := new(BTCTx)
+	tx.Hash = t.TxID
+	tx.ReportedFee = t.Fee
+	if t.Status.Confirmed {
+		tx.Time = time.Unix(t.Status.BlockTime, 0)
+	}
+
+	for _, in := range t.Vin {
+		if in.IsCoinbase || in.PrevOut == nil {
+			continue
+		}
+		out, err := in.PrevOut.convert()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", t.TxID, err)
+		}
+		tx.Inputs = append(tx.Inputs, out)
+	}
+
+	for _, o := range t.Vout {
+		out, err := o.convert()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", t.TxID, err)
+		}
+		tx.Outputs = append(tx.Outputs, out)
+	}
+	return tx, nil
+}
+
+func (e *Esplora) Tx(txid string) (*BTCTx, error) {
+	res := new(esploraTx)
+	if err := e.call("/tx/"+txid, res, true); err != nil {
+		return nil, err
+	}
+	return res.convert()
+}
+
+// TxTime returns the time of the block the transaction was confirmed in
+func (e *Esplora) TxTime(txid string) (time.Time, error) {
+	var status esploraStatus
+	if err := e.call("/tx/"+txid+"/status", &status, true); err != nil {
+		return time.Time{}, err
+	}
+	if !status.Confirmed {
+		return time.Time{}, fmt.Errorf("%s: transaction is unconfirmed", txid)
+	}
+	return time.Unix(status.BlockTime, 0), nil
+}
+
+// esploraPage is the number of transactions esplora returns per page
+const esploraPage = 25
+
+// AddressTxs returns the confirmed transactions of an address, 25 at a time.
+// The cursor is the last transaction id of the previous page.
+func (e *Esplora) AddressTxs(addr, cursor string) ([]*BTCTx, string, error) {
+	path := "/address/" + addr + "/txs/chain"
+	if cursor != "" {
+		path += "/" + cursor
+	}
+
+	var res []*esploraTx
+	if err := e.call(path, &res, false); err != nil {
+		return nil, "", err
+	}
+
+	var txs []*BTCTx
+	for _, t := range res {
+		tx, err := t.convert()
+		if err != nil {
+			return nil, "", err
+		}
+		txs = append(txs, tx)
+	}
+
+	if len(res) < esploraPage {
+		return txs, "", nil
+	}
+	return txs, res[len(res)-1].TxID, nil
+}
@@ -0,0 +1,216 @@
+package anchorcost
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+)
+
+// EthTx is an ethereum transaction. The max fee fields are only set for
+// EIP-1559 (type 2) transactions. Input is the call data of contract calls.
+type EthTx struct {
+	Hash                 string
+	To                   string
+	Input                []byte
+	Type                 uint64
+	BlockNumber          uint64
+	GasPrice             *big.Int // in wei
+	MaxFeePerGas         *big.Int
+	MaxPriorityFeePerGas *big.Int
+}
+
+// EthAccountTx is a mined transaction of an address history. GasPrice is
+// the price that was paid. Failed transactions were reverted or ran out of
+// gas and still paid for the gas they used.
+type EthAccountTx struct {
+	EthTx
+	From    string
+	Nonce   uint64
+	Time    time.Time
+	GasUsed uint64
+	Failed  bool
+}
+
+// Cost returns the wei that was paid for gas
+func (t *EthAccountTx) Cost() *big.Int {
+	return new(big.Int).Mul(new(big.Int).SetUint64(t.GasUsed), t.GasPrice)
+}
+
+// EthReceipt is the receipt of a mined ethereum transaction.
+// EffectiveGasPrice is nil if the provider does not report it.
+type EthReceipt struct {
+	GasUsed           uint64
+	EffectiveGasPrice *big.Int
+}
+
+// EthBlock holds the block fields needed for costs. BaseFee is nil for
+// blocks before the London fork.
+type EthBlock struct {
+	Number  uint64
+	Time    time.Time
+	BaseFee *big.Int
+}
+
+// EthBreakdown splits the cost of a transaction, in wei, into the base fee
+// that was burned and the tip that went to the miner. Before London, the
+// whole cost is tip.
+type EthBreakdown struct {
+	Type     uint64
+	GasUsed  uint64
+	GasPrice *big.Int
+	Total    *big.Int
+	Burned   *big.Int
+	Tip      *big.Int
+}
+
+// EffectiveGasPrice returns the price per gas that was actually paid
+func (tx *EthTx) EffectiveGasPrice(receipt *EthReceipt, block *EthBlock) (*big.Int, error) {
+	if receipt.EffectiveGasPrice != nil {
+		return receipt.EffectiveGasPrice, nil
+	}
+
+	if tx.Type != 2 {
+		return tx.GasPrice, nil
+	}
+
+	// the max fee is not what was paid, derive it the way the protocol does
+	if block.BaseFee == nil || tx.MaxFeePerGas == nil || tx.MaxPriorityFeePerGas == nil {
+		return nil, fmt.Errorf("%s: missing fee fields for type 2 transaction", tx.Hash)
+	}
+	tip := new(big.Int).Sub(tx.MaxFeePerGas, block.BaseFee)
+	if tip.Cmp(tx.MaxPriorityFeePerGas) > 0 {
+		tip.Set(tx.MaxPriorityFeePerGas)
+	}
+	return tip.Add(tip, block.BaseFee), nil
+}
+
+// Breakdown calculates the cost of a transaction
+func (tx *EthTx) Breakdown(receipt *EthReceipt, block *EthBlock) (*EthBreakdown, error) {
+	price, err := tx.EffectiveGasPrice(receipt, block)
+	if err != nil {
+		return nil, err
+	}
+
+	gas := new(big.Int).SetUint64(receipt.GasUsed)
+
+	b := new(EthBreakdown)
+	b.Type = tx.Type
+	b.GasUsed = receipt.GasUsed
+	b.GasPrice = price
+	b.Total = new(big.Int).Mul(gas, price)
+	b.Burned = new(big.Int)
+	if block.BaseFee != nil {
+		b.Burned.Mul(gas, block.BaseFee)
+	}
+	b.Tip = new(big.Int).Sub(b.Total, b.Burned)
+
+	if b.Tip.Sign() < 0 {
+		return nil, fmt.Errorf("%s: gas price %s is below the base fee %s", tx.Hash, price, block.BaseFee)
+	}
+	return b, nil
+}
+
+// EthFee returns the cost breakdown of an ethereum transaction
+func EthFee(p EthProvider, txid string) (*EthBreakdown, error) {
+	var tx *EthTx
+	var receipt *EthReceipt
+	var err error
+	if b, ok := p.(ethTxReceipter); ok {
+		tx, receipt, err = b.TxReceipt(txid)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		if tx, err = p.Tx(txid); err != nil {
+			return nil, err
+		}
+		if receipt, err = p.Receipt(txid); err != nil {
+			return nil, err
+		}
+	}
+
+	block, err := p.Block(tx.BlockNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx.Breakdown(receipt, block)
+}
+
+// SpreadRule decides which heights of an ethereum anchor window the cost of
+// the anchor is attributed to
+type SpreadRule string
+
+const (
+	SpreadEven  SpreadRule = "even"  // the same share for every height
+	SpreadFirst SpreadRule = "first" // everything to the lowest height
+	SpreadLast  SpreadRule = "last"  // everything to the anchored height
+)
+
+// Spread splits wei across the heights min to max, the shares start at min.
+// Even shares are exact, the wei left over from the division goes to the
+// lowest heights, one each.
+func Spread(wei *big.Int, min, max int64, rule SpreadRule) ([]*big.Int, error) {
+	if max < min {
+		return nil, fmt.Errorf("invalid window %d to %d", min, max)
+	}
+
+	shares := make([]*big.Int, max-min+1)
+	for i := range shares {
+		shares[i] = new(big.Int)
+	}
+
+	switch rule {
+	case SpreadEven:
+		n := big.NewInt(int64(len(shares)))
+		share, rem := new(big.Int).QuoRem(wei, n, new(big.Int))
+		extra := rem.Int64()
+		for i := range shares {
+			shares[i].Set(share)
+			if int64(i) < extra {
+				shares[i].Add(shares[i], big.NewInt(1))
+			}
+		}
+	case SpreadFirst:
+		shares[0].Set(wei)
+	case SpreadLast:
+		shares[len(shares)-1].Set(wei)
+	default:
+		return nil, fmt.Errorf("unknown spread rule %q", rule)
+	}
+	return shares, nil
+}
+
+var weiPerEth = big.NewInt(1e18)
+
+// FormatWei formats an amount of wei as ETH with all 18 decimals
+func FormatWei(wei *big.Int) string {
+	sign := ""
+	if wei.Sign() < 0 {
+		sign = "-"
+	}
+	whole, frac := new(big.Int).QuoRem(new(big.Int).Abs(wei), weiPerEth, new(big.Int))
+	return fmt.Sprintf("%s%s.%018s", sign, whole.String(), frac.String())
+}
+
+// ParseWei parses an ETH amount with up to 18 decimals into wei
+func ParseWei(eth string) (*big.Int, error) {
+	eth = strings.TrimSpace(eth)
+	whole, frac := eth, ""
+	if i := strings.IndexByte(eth, '.'); i >= 0 {
+		whole, frac = eth[:i], eth[i+1:]
+	}
+	if len(frac) > 18 {
+		return nil, fmt.Errorf("invalid eth amount %q", eth)
+	}
+	if whole == "" {
+		whole = "0"
+	}
+
+	wei, ok := new(big.Int).SetString(whole+frac+strings.Repeat("0", 18-len(frac)), 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid eth amount %q", eth)
+	}
+	return wei, nil
+}
@@ -0,0 +1,204 @@
+package anchorcost
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// EthNode is an EthProvider for the json-rpc api of an ethereum node such as
+// geth or erigon. No api key is required.
+type EthNode struct {
+	rpc *rpcClient
+}
+
+var _ EthProvider = (*EthNode)(nil)
+
+func NewEthNode(url string) *EthNode {
+	e := new(EthNode)
+	e.rpc = &rpcClient{url: url, version: "2.0", retry: DefaultRetry, bucket: "eth/node"}
+	return e
+}
+
+// SetRetry replaces the retry policy of failed requests
+func (e *EthNode) SetRetry(r RetryPolicy) {
+	e.rpc.retry = r
+}
+
+// SetCache stores mined transactions, their receipts and blocks in c
+func (e *EthNode) SetCache(c *Cache) {
+	e.rpc.cache = c
+}
+
+// ethMined accepts transactions that are in a block
+func ethMined(raw []byte) bool {
+	var tx struct {
+		BlockNumber *string `json:"blockNumber"`
+	}
+	return json.Unmarshal(raw, &tx) == nil && tx.BlockNumber != nil
+}
+
+type ethNodeTx struct {
+	Hash                 string `json:"hash"`
+	To                   string `json:"to"`
+	Input                string `json:"input"`
+	Type                 string `json:"type"`
+	BlockNumber          string `json:"blockNumber"`
+	GasPrice             string `json:"gasPrice"`
+	MaxFeePerGas         string `json:"maxFeePerGas"`
+	MaxPriorityFeePerGas string `json:"maxPriorityFeePerGas"`
+}
+
+func (t *ethNodeTx) convert() (*EthTx, error) {
+	var err error
+	tx := new(EthTx)
+	tx.Hash = t.Hash
+	tx.To = t.To
+	if t.BlockNumber == "" {
+		return nil, fmt.Errorf("%s: transaction %w", t.Hash, ErrPending)
+	}
+	if tx.BlockNumber, err = ethconv(t.BlockNumber); err != nil {
+		return nil, err
+	}
+	if tx.GasPrice, err = ethbig(t.GasPrice); err != nil {
+		return nil, err
+	}
+	if t.Type != "" {
+		if tx.Type, err = ethconv(t.Type); err != nil {
+			return nil, err
+		}
+	}
+	if tx.MaxFeePerGas, err = ethbigopt(t.MaxFeePerGas); err != nil {
+		return nil, err
+	}
+	if tx.MaxPriorityFeePerGas, err = ethbigopt(t.MaxPriorityFeePerGas); err != nil {
+		return nil, err
+	}
+	if tx.Input, err = ethbytes(t.Input); err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+type ethNodeReceipt struct {
+	GasUsed           string `json:"gasUsed"`
+	EffectiveGasPrice string `json:"effectiveGasPrice"`
+}
+
+func (r *ethNodeReceipt) convert() (*EthReceipt, error) {
+	var err error
+	receipt := new(EthReceipt)
+	if receipt.GasUsed, err = ethconv(r.GasUsed); err != nil {
+		return nil, err
+	}
+	if receipt.EffectiveGasPrice, err = ethbigopt(r.EffectiveGasPrice); err != nil {
+		return nil, err
+	}
+	return receipt, nil
+}
+
+type ethNodeBlock struct {
+	Timestamp     string `json:"timestamp"`
+	BaseFeePerGas string `json:"baseFeePerGas"`
+}
+
+func (b *ethNodeBlock) convert(number uint64) (*EthBlock, error) {
+	unixts, err := ethconv(b.Timestamp)
+	if err != nil {
+		return nil, err
+	}
+
+	block := new(EthBlock)
+	block.Number = number
+	block.Time = time.Unix(int64(unixts), 0)
+	if block.BaseFee, err = ethbigopt(b.BaseFeePerGas); err != nil {
+		return nil, err
+	}
+	return block, nil
+}
+
+func (e *EthNode) Tx(txid string) (*EthTx, error) {
+	var res *ethNodeTx
+	if err := e.rpc.cachedCall("tx/"+txid, ethMined, "eth_getTransactionByHash", &res, txid); err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, fmt.Errorf("%s: transaction %w", txid, ErrNotFound)
+	}
+	return res.convert()
+}
+
+func (e *EthNode) Receipt(txid string) (*EthReceipt, error) {
+	var res *ethNodeReceipt
+	if err := e.rpc.cachedCall("receipt/"+txid, rpcObject, "eth_getTransactionReceipt", &res, txid); err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, fmt.Errorf("%s: receipt %w", txid, ErrNotFound)
+	}
+	return res.convert()
+}
+
+// TxReceipt fetches a transaction and its receipt in a single batch
+func (e *EthNode) TxReceipt(txid string) (*EthTx, *EthReceipt, error) {
+	cache, bucket := e.rpc.cache, e.rpc.bucket
+	rawTx := json.RawMessage(cache.get(bucket, "tx/"+txid))
+	rawReceipt := json.RawMessage(cache.get(bucket, "receipt/"+txid))
+
+	if rawTx == nil || rawReceipt == nil {
+		calls := []*rpcCall{
+			{Method: "eth_getTransactionByHash", Params: []interface{}{txid}, Result: &rawTx},
+			{Method: "eth_getTransactionReceipt", Params: []interface{}{txid}, Result: &rawReceipt},
+		}
+		if err := e.rpc.batch(calls...); err != nil {
+			return nil, nil, err
+		}
+		for _, c := range calls {
+			if c.Err != nil {
+				return nil, nil, c.Err
+			}
+		}
+
+		if ethMined(rawTx) && rpcObject(rawReceipt) {
+			if err := cache.put(bucket, "tx/"+txid, rawTx); err != nil {
+				return nil, nil, err
+			}
+			if err := cache.put(bucket, "receipt/"+txid, rawReceipt); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+
+	var rtx *ethNodeTx
+	var rreceipt *ethNodeReceipt
+	if err := decode(rawTx, &rtx); err != nil {
+		return nil, nil, err
+	}
+	if err := decode(rawReceipt, &rreceipt); err != nil {
+		return nil, nil, err
+	}
+	if rtx == nil || rreceipt == nil {
+		return nil, nil, fmt.Errorf("%s: transaction %w", txid, ErrNotFound)
+	}
+
+	tx, err := rtx.convert()
+	if err != nil {
+		return nil, nil, err
+	}
+	receipt, err := rreceipt.convert()
+	if err != nil {
+		return nil, nil, err
+	}
+	return tx, receipt, nil
+}
+
+func (e *EthNode) Block(number uint64) (*EthBlock, error) {
+	var res *ethNodeBlock
+	if err := e.rpc.cachedCall(fmt.Sprintf("block/%d", number), rpcObject, "eth_getBlockByNumber", &res, fmt.Sprintf("0x%x", number), false); err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, fmt.Errorf("block %d %w", number, ErrNotFound)
+	}
+	return res.convert(number)
+}
@@ -0,0 +1,324 @@
+package anchorcost
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/ratelimit"
+)
+
+// https://api.etherscan.io/api?module=proxy&action=eth_getTransactionByHash&txhash=0x1e2910a262b1008d0616a0beb24c1a491d78771baa54a33e66065e03b1f46bc1&apikey=YourApiKeyToken
+// https://api.etherscan.io/api?module=proxy&action=eth_getTransactionReceipt&txhash=0x1e2910a262b1008d0616a0beb24c1a491d78771baa54a33e66065e03b1f46bc1&apikey=YourApiKeyToken
+
+const ETH_URL = "https://api.etherscan.io/api"
+const ETH_LIMIT = 5
+
+// Ethscan is a rate limited EthProvider for the etherscan.io proxy api
+type Ethscan struct {
+	key   string
+	url   string
+	limit ratelimit.Limiter
+	retry RetryPolicy
+	cache *Cache
+}
+
+var _ EthProvider = (*Ethscan)(nil)
+var _ EthHistory = (*Ethscan)(nil)
+
+// NewEthscan creates a client for the api at url, usually ETH_URL, that makes
+// at most limit requests per second. A limit of 0 is unlimited.
+func NewEthscan(key, url string, limit int) *Ethscan {
+	e := new(Ethscan)
+	e.key = key
+	e.url = url
+	e.limit = newLimiter(limit)
+	e.retry = DefaultRetry
+	return e
+}
+
+// SetRetry replaces the retry policy of failed requests
+func (e *Ethscan) SetRetry(r RetryPolicy) {
+	e.retry = r
+}
+
+// SetCache stores mined transactions, their receipts and blocks in c
+func (e *Ethscan) SetCache(c *Cache) {
+	e.cache = c
+}
+
+// etherscanResponse is the envelope of etherscan responses. The proxy module
+// answers like a json-rpc node, the other modules with a status and message.
+// Failures of either can come with a plain string as result.
+type etherscanResponse struct {
+	Status  string          `json:"status"`
+	Message string          `json:"message"`
+	Result  json.RawMessage `json:"result"`
+	Error   *rpcError       `json:"error"`
+}
+
+func (r *etherscanResponse) err() error {
+	if r.Error != nil {
+		return r.Error
+	}
+
+	// a plain string result is a message, unless it is a hex quantity
+	var msg string
+	if json.Unmarshal(r.Result, &msg) != nil || strings.HasPrefix(msg, "0x") {
+		msg = ""
+	}
+
+	switch {
+	case r.Status == "0" && r.Message == "No transactions found":
+		// the account module reports empty lists as failures
+		return nil
+	case strings.Contains(strings.ToLower(msg), "rate limit"):
+		return fmt.Errorf("etherscan: %w: %s", ErrRateLimited, msg)
+	case r.Status == "0":
+		return fmt.Errorf("etherscan: %s: %s", r.Message, msg)
+	case msg != "":
+		return fmt.Errorf("etherscan: %s", msg)
+	}
+	return nil
+}
+
+// api returns the url of an api call
+func (e *Ethscan) api(module, action string, params url.Values) string {
+	params.Set("module", module)
+	params.Set("action", action)
+	params.Set("apikey", e.key)
+	return e.url + "?" + params.Encode()
+}
+
+// call fetches url and checks the envelope, so rate limits are retried like
+// they would be for an http status
+func (e *Ethscan) call(url string) ([]byte, error) {
+	var body []byte
+	err := e.retry.Do(func() error {
+		e.limit.Take()
+		var err error
+		if body, err = httpGet(url); err != nil {
+			return err
+		}
+
+		var res etherscanResponse
+		if err := decode(body, &res); err != nil {
+			return err
+		}
+		return res.err()
+	})
+	return body, err
+}
+
+// etherscanKeep applies keep to the result of a proxy response
+func etherscanKeep(keep func([]byte) bool) func([]byte) bool {
+	return func(data []byte) bool {
+		var res etherscanResponse
+		return json.Unmarshal(data, &res) == nil && keep(res.Result)
+	}
+}
+
+// proxy decodes the result of a proxy module call into result, which should
+// be a pointer to a pointer so a null result can be told apart
+func (e *Ethscan) proxy(key, url string, keep func([]byte) bool, result interface{}) error {
+	body, err := e.cache.cached("eth/etherscan", key, func() ([]byte, error) {
+		return e.call(url)
+	}, etherscanKeep(keep))
+	if err != nil {
+		return err
+	}
+
+	var res etherscanResponse
+	if err := decode(body, &res); err != nil {
+		return err
+	}
+	return decode(res.Result, result)
+}
+
+// Tx returns ErrPending for transactions that etherscan does not know or
+// that are not mined yet
+func (e *Ethscan) Tx(txid string) (*EthTx, error) {
+	var res *ethNodeTx
+	if err := e.proxy("tx/"+txid, e.api("proxy", "eth_getTransactionByHash", url.Values{"txhash": {txid}}), ethMined, &res); err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, fmt.Errorf("%s: transaction %w", txid, ErrPending)
+	}
+	return res.convert()
+}
+
+func (e *Ethscan) Receipt(txid string) (*EthReceipt, error) {
+	var res *ethNodeReceipt
+	if err := e.proxy("receipt/"+txid, e.api("proxy", "eth_getTransactionReceipt", url.Values{"txhash": {txid}}), rpcObject, &res); err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, fmt.Errorf("%s: receipt %w", txid, ErrPending)
+	}
+	return res.convert()
+}
+
+func (e *Ethscan) Block(number uint64) (*EthBlock, error) {
+	var res *ethNodeBlock
+	if err := e.proxy(fmt.Sprintf("block/%d", number), e.api("proxy", "eth_getBlockByNumber", url.Values{"tag": {fmt.Sprintf("0x%x", number)}, "boolean": {"false"}}), rpcObject, &res); err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, fmt.Errorf("block %d %w", number, ErrNotFound)
+	}
+	return res.convert(number)
+}
+
+// etherscanPage is the number of transactions per txlist page
+const etherscanPage = 1000
+
+// etherscanAccountTx is a transaction of the account module, which uses
+// decimal numbers
+type etherscanAccountTx struct {
+	BlockNumber   string `json:"blockNumber"`
+	TimeStamp     string `json:"timeStamp"`
+	Hash          string `json:"hash"`
+	Nonce         string `json:"nonce"`
+	From          string `json:"from"`
+	To            string `json:"to"`
+	Input         string `json:"input"`
+	GasPrice      string `json:"gasPrice"`
+	GasUsed       string `json:"gasUsed"`
+	IsError       string `json:"isError"`
+	ReceiptStatus string `json:"txreceipt_status"`
+}
+
+func (t *etherscanAccountTx) convert() (*EthAccountTx, error) {
+	tx := new(EthAccountTx)
+	tx.Hash, tx.From, tx.To = t.Hash, t.From, t.To
+	var err error
+	var unix uint64
+	for _, f := range []struct {
+		dst *uint64
+		src string
+	}{{&tx.BlockNumber, t.BlockNumber}, {&unix, t.TimeStamp}, {&tx.Nonce, t.Nonce}, {&tx.GasUsed, t.GasUsed}} {
+		if *f.dst, err = strconv.ParseUint(f.src, 10, 64); err != nil {
+			return nil, fmt.Errorf("%s: %w: %v", t.Hash, ErrMalformed, err)
+		}
+	}
+	tx.Time = time.Unix(int64(unix), 0)
+
+	var ok bool
+	if tx.GasPrice, ok = new(big.Int).SetString(t.GasPrice, 10); !ok {
+		return nil, fmt.Errorf("%s: %w: invalid gas price %q", t.Hash, ErrMalformed, t.GasPrice)
+	}
+	if tx.Input, err = ethbytes(t.Input); err != nil {
+		return nil, fmt.Errorf("%s: %w", t.Hash, err)
+	}
+	// the receipt status is empty before byzantium
+	tx.Failed = t.IsError == "1" || t.ReceiptStatus == "0"
+	return tx, nil
+}
+
+// AddressTxs lists the transactions of an address with the txlist action.
+// Etherscan only pages through the first 10000 results of a query, so the
+// cursor is the highest block of the next page instead of a page number.
+func (e *Ethscan) AddressTxs(addr, cursor string) ([]*EthAccountTx, string, error) {
+	params := url.Values{
+		"address":    {addr},
+		"startblock": {"0"},
+		"page":       {"1"},
+		"offset":     {strconv.Itoa(etherscanPage)},
+		"sort":       {"desc"},
+	}
+	if cursor != "" {
+		params.Set("endblock", cursor)
+	}
+
+	if err := e.cache.live("address " + addr); err != nil {
+		return nil, "", err
+	}
+
+	body, err := e.call(e.api("account", "txlist", params))
+	if err != nil {
+		return nil, "", err
+	}
+	var res etherscanResponse
+	if err := decode(body, &res); err != nil {
+		return nil, "", err
+	}
+	if res.Status == "0" {
+		// no transactions found
+		return nil, "", nil
+	}
+	var list []etherscanAccountTx
+	if err := decode(res.Result, &list); err != nil {
+		return nil, "", err
+	}
+
+	var txs []*EthAccountTx
+	for _, t := range list {
+		tx, err := t.convert()
+		if err != nil {
+			return nil, "", err
+		}
+		txs = append(txs, tx)
+	}
+
+	if len(txs) < etherscanPage {
+		return txs, "", nil
+	}
+
+	// the page may end in the middle of a block, so the next one starts
+	// with the whole block again
+	last := txs[len(txs)-1].BlockNumber
+	i := len(txs)
+	for i > 0 && txs[i-1].BlockNumber == last {
+		i--
+	}
+	if i == 0 {
+		// a full page of a single block, the rest of it can not be paged to
+		if last == 0 {
+			return txs, "", nil
+		}
+		return txs, strconv.FormatUint(last-1, 10), nil
+	}
+	return txs[:i], strconv.FormatUint(last, 10), nil
+}
+
+func ethconv(num interface{}) (uint64, error) {
+	n, err := strconv.ParseUint((strings.Replace(fmt.Sprintf("%v", num), "0x", "", 1)), 16, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %v", ErrMalformed, err)
+	}
+	return n, nil
+}
+
+// ethbig parses a hex quantity that may not fit into 64 bits, like wei amounts
+func ethbig(num interface{}) (*big.Int, error) {
+	s := fmt.Sprintf("%v", num)
+	n, ok := new(big.Int).SetString(strings.Replace(s, "0x", "", 1), 16)
+	if !ok {
+		return nil, fmt.Errorf("%w: invalid quantity %q", ErrMalformed, s)
+	}
+	return n, nil
+}
+
+// ethbytes decodes hex data like transaction input
+func ethbytes(data string) ([]byte, error) {
+	b, err := hex.DecodeString(strings.TrimPrefix(data, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrMalformed, err)
+	}
+	return b, nil
+}
+
+// ethbigopt is ethbig for fields that are missing in older transactions and
+// blocks, which are returned as nil
+func ethbigopt(num interface{}) (*big.Int, error) {
+	if num == nil || num == "" {
+		return nil, nil
+	}
+	return ethbig(num)
+}
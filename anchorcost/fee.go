@@ -0,0 +1,316 @@
+// Package anchorcost contains the shared clients and file formats used to
+// calculate how much Factom spent on anchoring into Bitcoin and Ethereum.
+package anchorcost
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TimeFormat is the layout used for all dates written to and read from files
+const TimeFormat = "2006-01-02 15:04"
+
+// Fee is a single anchor record of a cost file. Amount is the fee exactly
+// as it was written in the file, Fee is the same amount as a float for
+// calculations. TxTime is only set for files that have a date column.
+type Fee struct {
+	Height int
+	Hash   string
+	Fee    float64
+	Amount string
+	TxTime time.Time
+}
+
+// ParseError is a line of an input file that could not be read. Line is 0
+// if the error is not about a single line.
+type ParseError struct {
+	File string
+	Line int
+	Err  error
+}
+
+func (e *ParseError) Error() string {
+	if e.Line == 0 {
+		return fmt.Sprintf("%s: %v", e.File, e.Err)
+	}
+	return fmt.Sprintf("%s:%d: %v", e.File, e.Line, e.Err)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// columns splits a csv line, it needs at least n columns
+func columns(line string, n int) ([]string, error) {
+	tokens := strings.Split(line, ",")
+	if len(tokens) < n {
+		return nil, fmt.Errorf("expected %d columns, found %d", n, len(tokens))
+	}
+	return tokens, nil
+}
+
+// LoadCosts reads a file that starts with the "Height,TxID,Fee" columns.
+// The first line is a header and if it contains a "TxDate" column, the dates
+// are read as well. Transactions that appear multiple times are only
+// returned once. Files written by spread are recognized by their
+// "DBHeightMin" and "DBHeightMax" columns, they have a row for every height
+// of a transaction's window and only rows that repeat a height and
+// transaction are dropped.
+func LoadCosts(fname string) ([]Fee, error) {
+	f, err := os.Open(fname)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	dupl := make(map[string]bool)
+	key := func(fee Fee) string { return fee.Hash }
+
+	var res []Fee
+	sc := bufio.NewScanner(f)
+	date := -1
+	for line := 1; sc.Scan(); line++ {
+		if line == 1 {
+			for i, col := range strings.Split(sc.Text(), ",") {
+				switch strings.TrimSpace(col) {
+				case "TxDate":
+					date = i
+				case "DBHeightMin", "DBHeightMax":
+					key = func(fee Fee) string { return fmt.Sprintf("%d/%s", fee.Height, fee.Hash) }
+				}
+			}
+			continue
+		}
+		if strings.TrimSpace(sc.Text()) == "" {
+			continue
+		}
+
+		fee, err := parseFee(sc.Text(), date)
+		if err != nil {
+			return nil, &ParseError{File: fname, Line: line, Err: err}
+		}
+
+		if dupl[key(fee)] {
+			continue
+		}
+		dupl[key(fee)] = true
+		res = append(res, fee)
+	}
+
+	if err := sc.Err(); err != nil {
+		return nil, &ParseError{File: fname, Err: err}
+	}
+	return res, nil
+}
+
+// parseFee reads a line of a cost file, date is the index of the TxDate
+// column or -1
+func parseFee(line string, date int) (Fee, error) {
+	tokens, err := columns(line, 3)
+	if err != nil {
+		return Fee{}, err
+	}
+
+	height, err := strconv.Atoi(strings.TrimSpace(tokens[0]))
+	if err != nil {
+		return Fee{}, err
+	}
+	amount := strings.TrimSpace(tokens[2])
+	fee, err := strconv.ParseFloat(amount, 64)
+	if err != nil {
+		return Fee{}, err
+	}
+
+	var t time.Time
+	if date >= 0 && date < len(tokens) {
+		t, err = time.Parse(TimeFormat, strings.TrimSpace(tokens[date]))
+		if err != nil {
+			return Fee{}, err
+		}
+	}
+
+	return Fee{
+		Height: height,
+		Hash:   strings.TrimSpace(tokens[1]),
+		Fee:    fee,
+		Amount: amount,
+		TxTime: t,
+	}, nil
+}
+
+// Anchor is a line of an orphans file, an anchor transaction with the
+// height and KeyMR found in its OP_RETURN output. Fee and Class are only
+// set for files that have those columns.
+type Anchor struct {
+	TxID   string
+	Height int
+	KeyMR  string
+	TxTime time.Time
+	Fee    string
+	Class  string
+}
+
+// LoadAnchors reads a file with the "TxID,Height,KeyMR,TxDate" columns,
+// optionally followed by "Fee,Class"
+func LoadAnchors(fname string) ([]Anchor, error) {
+	f, err := os.Open(fname)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var res []Anchor
+	sc := bufio.NewScanner(f)
+	for line := 1; sc.Scan(); line++ {
+		if line == 1 || strings.TrimSpace(sc.Text()) == "" {
+			continue
+		}
+
+		a, err := parseAnchor(sc.Text())
+		if err != nil {
+			return nil, &ParseError{File: fname, Line: line, Err: err}
+		}
+		res = append(res, a)
+	}
+
+	if err := sc.Err(); err != nil {
+		return nil, &ParseError{File: fname, Err: err}
+	}
+	return res, nil
+}
+
+func parseAnchor(line string) (Anchor, error) {
+	tokens, err := columns(line, 4)
+	if err != nil {
+		return Anchor{}, err
+	}
+
+	height, err := strconv.Atoi(strings.TrimSpace(tokens[1]))
+	if err != nil {
+		return Anchor{}, err
+	}
+	t, err := time.Parse(TimeFormat, strings.TrimSpace(tokens[3]))
+	if err != nil {
+		return Anchor{}, err
+	}
+
+	a := Anchor{
+		TxID:   strings.TrimSpace(tokens[0]),
+		Height: height,
+		KeyMR:  strings.TrimSpace(tokens[2]),
+		TxTime: t,
+	}
+	if len(tokens) >= 6 {
+		a.Fee, a.Class = strings.TrimSpace(tokens[4]), strings.TrimSpace(tokens[5])
+	}
+	return a, nil
+}
+
+// LoadHeights reads the heights of a cost file
+func LoadHeights(fname string) (map[int]bool, error) {
+	f, err := os.Open(fname)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	res := make(map[int]bool)
+	sc := bufio.NewScanner(f)
+	for line := 1; sc.Scan(); line++ {
+		if line == 1 || strings.TrimSpace(sc.Text()) == "" {
+			continue
+		}
+
+		tokens := strings.Split(sc.Text(), ",")
+
+		height, err := strconv.Atoi(strings.TrimSpace(tokens[0]))
+		if err != nil {
+			return nil, &ParseError{File: fname, Line: line, Err: err}
+		}
+
+		res[height] = true
+	}
+
+	if err := sc.Err(); err != nil {
+		return nil, &ParseError{File: fname, Err: err}
+	}
+	return res, nil
+}
+
+// LoadPrices reads a daily price file from CryptoDataDownload and returns
+// the average of high and low for each day
+func LoadPrices(fname string) (map[time.Time]float64, error) {
+	f, err := os.Open(fname)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	/*
+	   Timestamps are UTC timezone,https://www.CryptoDataDownload.com
+	   Date,Symbol,Open,High,Low,Close,Volume BTC,Volume USD
+	   2020-08-10 09-PM,BTCUSD,11863.32,11869.26,11800.06,11834.33,571.75,6759675.81
+	   0                  1      2        3        4         5        6     7
+	*/
+	res := make(map[time.Time]float64)
+	sc := bufio.NewScanner(f)
+	for line := 1; sc.Scan(); line++ {
+		if line <= 2 || strings.TrimSpace(sc.Text()) == "" {
+			continue
+		}
+
+		t, price, err := parsePrice(sc.Text())
+		if err != nil {
+			return nil, &ParseError{File: fname, Line: line, Err: err}
+		}
+		res[t] = price
+	}
+
+	if err := sc.Err(); err != nil {
+		return nil, &ParseError{File: fname, Err: err}
+	}
+	return res, nil
+}
+
+// parsePrice reads the day and the average of high and low of a price line
+func parsePrice(line string) (time.Time, float64, error) {
+	tokens, err := columns(line, 5)
+	if err != nil {
+		return time.Time{}, 0, err
+	}
+
+	t, err := time.Parse("2006-01-02", tokens[0])
+	if err != nil {
+		return time.Time{}, 0, err
+	}
+
+	high, err := strconv.ParseFloat(tokens[3], 64)
+	if err != nil {
+		return time.Time{}, 0, err
+	}
+	low, err := strconv.ParseFloat(tokens[4], 64)
+	if err != nil {
+		return time.Time{}, 0, err
+	}
+
+	return t, (high + low) / 2, nil
+}
+
+// LoadBlockTimes reads a json map of factom block heights to block times
+func LoadBlockTimes(fname string) (map[int]time.Time, error) {
+	data, err := ioutil.ReadFile(fname)
+	if err != nil {
+		return nil, err
+	}
+
+	blocktimes := make(map[int]time.Time)
+	if err := json.Unmarshal(data, &blocktimes); err != nil {
+		return nil, &ParseError{File: fname, Err: err}
+	}
+	return blocktimes, nil
+}
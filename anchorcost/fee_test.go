@@ -0,0 +1,38 @@
+package anchorcost
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadCosts(t *testing.T) {
+	dir, err := ioutil.TempDir("", "fee")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	tests := []struct {
+		name string
+		file string
+		rows int
+	}{
+		{"costs", "Height,TxID,Fee\n10,0xa,1\n11,0xa,1\n12,0xb,2\n", 2},
+		{"spread", "Height,TxID,Fee,DBHeightMin,DBHeightMax\n10,0xa,0.5,10,11\n11,0xa,0.5,10,11\n11,0xa,0.5,10,11\n", 2},
+	}
+	for _, tt := range tests {
+		name := filepath.Join(dir, tt.name+".txt")
+		if err := ioutil.WriteFile(name, []byte(tt.file), 0644); err != nil {
+			t.Fatal(err)
+		}
+		costs, err := LoadCosts(name)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if len(costs) != tt.rows {
+			t.Errorf("%s: %d rows, want %d", tt.name, len(costs), tt.rows)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package anchorcost
+
+import "time"
+
+// BTCProvider is a source of bitcoin transaction data
+type BTCProvider interface {
+	// Tx returns a single transaction
+	Tx(txid string) (*BTCTx, error)
+	// AddressTxs returns a page of the transactions of an address, newest
+	// first. An empty cursor starts at the newest transaction and the
+	// returned cursor points to the next page, it is empty after the last
+	// page.
+	AddressTxs(addr, cursor string) ([]*BTCTx, string, error)
+}
+
+// EthProvider is a source of ethereum transaction data
+type EthProvider interface {
+	// Tx returns a single transaction
+	Tx(txid string) (*EthTx, error)
+	// Receipt returns the receipt of a mined transaction
+	Receipt(txid string) (*EthReceipt, error)
+	// Block returns the header fields of a block
+	Block(number uint64) (*EthBlock, error)
+}
+
+// EthHistory is implemented by providers that can list the transactions of
+// an address, which nodes can not
+type EthHistory interface {
+	// AddressTxs returns a page of the mined transactions from and to an
+	// address, failed ones included, newest first. An empty cursor starts
+	// at the newest transaction and the returned cursor is empty after the
+	// last page.
+	AddressTxs(addr, cursor string) ([]*EthAccountTx, string, error)
+}
+
+// ethTxReceipter is implemented by providers that can fetch a transaction
+// and its receipt in a single round trip
+type ethTxReceipter interface {
+	TxReceipt(txid string) (*EthTx, *EthReceipt, error)
+}
+
+// btcTimer is implemented by providers that can look up the time of a
+// transaction without fetching the whole transaction
+type btcTimer interface {
+	TxTime(txid string) (time.Time, error)
+}
+
+// BTCTime returns the time of a bitcoin transaction
+func BTCTime(p BTCProvider, txid string) (time.Time, error) {
+	if t, ok := p.(btcTimer); ok {
+		return t.TxTime(txid)
+	}
+
+	tx, err := p.Tx(txid)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return tx.Time, nil
+}
+
+// EthTime returns the timestamp of the block an ethereum transaction was mined in
+func EthTime(p EthProvider, txid string) (time.Time, error) {
+	tx, err := p.Tx(txid)
+	if err != nil {
+		return time.Time{}, err
+	}
+	block, err := p.Block(tx.BlockNumber)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return block.Time, nil
+}
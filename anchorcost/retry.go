@@ -0,0 +1,151 @@
+package anchorcost
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"go.uber.org/ratelimit"
+)
+
+// Provider errors are wrapped around one of these classes, check them with
+// errors.Is. Only rate limit and network errors are retried. ErrPending is
+// for transactions that are not in a block (yet), they may be worth looking
+// up again later.
+var (
+	ErrRateLimited = errors.New("rate limited")
+	ErrNotFound    = errors.New("not found")
+	ErrPending     = errors.New("not found or pending")
+	ErrMalformed   = errors.New("malformed response")
+	ErrNetwork     = errors.New("network error")
+)
+
+// Class returns the error class of err or nil if it has none
+func Class(err error) error {
+	for _, class := range []error{ErrRateLimited, ErrNotFound, ErrPending, ErrMalformed, ErrNetwork, ErrCacheMiss} {
+		if errors.Is(err, class) {
+			return class
+		}
+	}
+	return nil
+}
+
+// Retryable checks if a request that failed with err may succeed later
+func Retryable(err error) bool {
+	return errors.Is(err, ErrRateLimited) || errors.Is(err, ErrNetwork)
+}
+
+// RetryPolicy retries retryable errors with exponential backoff and full
+// jitter: the n-th retry waits a random time between zero and Base * 2^n,
+// capped at Max.
+type RetryPolicy struct {
+	Attempts int
+	Base     time.Duration
+	Max      time.Duration
+}
+
+// DefaultRetry is the policy providers start with
+var DefaultRetry = RetryPolicy{Attempts: 6, Base: time.Second, Max: time.Minute}
+
+// Do runs fn until it succeeds, fails with an error that is not retryable,
+// or runs out of attempts
+func (r RetryPolicy) Do(fn func() error) error {
+	var err error
+	for attempt := 0; ; attempt++ {
+		if err = fn(); err == nil || !Retryable(err) || attempt+1 >= r.Attempts {
+			return err
+		}
+
+		wait := r.Base << uint(attempt)
+		if wait > r.Max || wait <= 0 {
+			wait = r.Max
+		}
+		time.Sleep(time.Duration(rand.Int63n(int64(wait) + 1)))
+	}
+}
+
+// newLimiter returns a limiter for limit requests per second, 0 is unlimited
+func newLimiter(limit int) ratelimit.Limiter {
+	if limit <= 0 {
+		return ratelimit.NewUnlimited()
+	}
+	return ratelimit.New(limit)
+}
+
+// httpTimeout limits how long a single request, including reading the
+// response, may take before it fails as a network error and is retried
+const httpTimeout = time.Minute
+
+// httpClient is shared by all providers. Without a timeout a stalled
+// connection would block a worker forever.
+var httpClient = &http.Client{Timeout: httpTimeout}
+
+// httpDo sends a request and reads the response. Only transport failures are
+// returned as errors, they are network errors, timeouts included.
+func httpDo(req *http.Request) ([]byte, *http.Response, error) {
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		// the full url can contain api keys
+		var uerr *url.Error
+		if errors.As(err, &uerr) {
+			err = uerr.Err
+		}
+		return nil, nil, fmt.Errorf("%w: %s %s: %v", ErrNetwork, req.Method, req.URL.Host+req.URL.Path, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrNetwork, err)
+	}
+	return body, resp, nil
+}
+
+// httpGet fetches url, any status but 200 is an error
+func httpGet(url string) ([]byte, error) {
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, resp, err := httpDo(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := statusError(resp); err != nil {
+		if msg := strings.TrimSpace(string(body)); msg != "" {
+			return nil, fmt.Errorf("%s: %w: %s", req.URL.Path, err, msg)
+		}
+		return nil, fmt.Errorf("%s: %w", req.URL.Path, err)
+	}
+	return body, nil
+}
+
+// statusError classifies http error responses
+func statusError(resp *http.Response) error {
+	switch {
+	case resp.StatusCode == http.StatusOK:
+		return nil
+	case resp.StatusCode == http.StatusTooManyRequests:
+		return ErrRateLimited
+	case resp.StatusCode == http.StatusNotFound:
+		return ErrNotFound
+	case resp.StatusCode >= 500:
+		return fmt.Errorf("%w: %s", ErrNetwork, resp.Status)
+	}
+	return errors.New(resp.Status)
+}
+
+// decode unmarshals a response, classifying failures as malformed
+func decode(data []byte, v interface{}) error {
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("%w: %v", ErrMalformed, err)
+	}
+	return nil
+}
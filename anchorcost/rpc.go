@@ -0,0 +1,210 @@
+package anchorcost
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync/atomic"
+)
+
+// rpcClient is a minimal json-rpc client over http
+type rpcClient struct {
+	id      uint64
+	url     string
+	user    string
+	pass    string
+	version string
+	retry   RetryPolicy
+	cache   *Cache
+	bucket  string
+}
+
+type rpcRequest struct {
+	JSONRPC string        `json:"jsonrpc"`
+	ID      uint64        `json:"id"`
+	Method  string        `json:"method"`
+	Params  []interface{} `json:"params"`
+}
+
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *rpcError) Error() string {
+	return fmt.Sprintf("rpc error %d: %s", e.Code, e.Message)
+}
+
+// Unwrap returns the class of the errors nodes are known to send
+func (e *rpcError) Unwrap() error {
+	switch {
+	case e.Code == -5: // bitcoind: no such transaction or block
+		return ErrNotFound
+	case e.Code == -28: // bitcoind: still loading
+		return ErrNetwork
+	case e.Code == -32005, strings.Contains(strings.ToLower(e.Message), "rate limit"):
+		return ErrRateLimited
+	}
+	return nil
+}
+
+type rpcResponse struct {
+	ID     uint64          `json:"id"`
+	Result json.RawMessage `json:"result"`
+	Error  *rpcError       `json:"error"`
+}
+
+// rpcCall is a single call of a batch
+type rpcCall struct {
+	Method string
+	Params []interface{}
+	Result interface{}
+	Err    error
+}
+
+func (c *rpcClient) request(method string, params []interface{}) rpcRequest {
+	if params == nil {
+		params = []interface{}{}
+	}
+	return rpcRequest{
+		JSONRPC: c.version,
+		ID:      atomic.AddUint64(&c.id, 1),
+		Method:  method,
+		Params:  params,
+	}
+}
+
+// post sends a single request. Error statuses with a json body are not an
+// error since bitcoind reports rpc errors that way.
+func (c *rpcClient) post(data []byte) ([]byte, error) {
+	req, err := http.NewRequest("POST", c.url, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if c.user != "" || c.pass != "" {
+		req.SetBasicAuth(c.user, c.pass)
+	}
+
+	body, resp, err := httpDo(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := statusError(resp); err != nil && !json.Valid(body) {
+		return nil, err
+	}
+	return body, nil
+}
+
+func (c *rpcClient) call(method string, result interface{}, params ...interface{}) error {
+	if err := c.cache.live(method); err != nil {
+		return err
+	}
+
+	raw, err := c.callRaw(method, params)
+	if err != nil {
+		return err
+	}
+	return decode(raw, result)
+}
+
+// cachedCall is call with the result stored in the cache under key, once
+// keep accepts it
+func (c *rpcClient) cachedCall(key string, keep func([]byte) bool, method string, result interface{}, params ...interface{}) error {
+	raw, err := c.cache.cached(c.bucket, key, func() ([]byte, error) {
+		return c.callRaw(method, params)
+	}, keep)
+	if err != nil {
+		return err
+	}
+	return decode(raw, result)
+}
+
+func (c *rpcClient) callRaw(method string, params []interface{}) (json.RawMessage, error) {
+	data, err := json.Marshal(c.request(method, params))
+	if err != nil {
+		return nil, err
+	}
+
+	var result json.RawMessage
+	err = c.retry.Do(func() error {
+		body, err := c.post(data)
+		if err != nil {
+			return fmt.Errorf("%s: %w", method, err)
+		}
+
+		res := rpcResponse{}
+		if err := decode(body, &res); err != nil {
+			return fmt.Errorf("%s: %w", method, err)
+		}
+		if res.Error != nil {
+			return fmt.Errorf("%s: %w", method, res.Error)
+		}
+		result = res.Result
+		return nil
+	})
+	return result, err
+}
+
+// batch sends all calls in a single request. The returned error is only set
+// if the request itself failed, errors of individual calls are stored in
+// their Err field.
+func (c *rpcClient) batch(calls ...*rpcCall) error {
+	if err := c.cache.live("batch"); err != nil {
+		return err
+	}
+
+	reqs := make([]rpcRequest, len(calls))
+	byID := make(map[uint64]*rpcCall)
+	for i, call := range calls {
+		reqs[i] = c.request(call.Method, call.Params)
+		byID[reqs[i].ID] = call
+	}
+
+	data, err := json.Marshal(reqs)
+	if err != nil {
+		return err
+	}
+
+	var res []rpcResponse
+	err = c.retry.Do(func() error {
+		body, err := c.post(data)
+		if err != nil {
+			return fmt.Errorf("batch: %w", err)
+		}
+		if err := decode(body, &res); err != nil {
+			return fmt.Errorf("batch: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, r := range res {
+		call, ok := byID[r.ID]
+		if !ok {
+			continue
+		}
+		delete(byID, r.ID)
+
+		if r.Error != nil {
+			call.Err = fmt.Errorf("%s: %w", call.Method, r.Error)
+		} else {
+			call.Err = decode(r.Result, call.Result)
+		}
+	}
+	for _, call := range byID {
+		call.Err = fmt.Errorf("%s: no response in batch", call.Method)
+	}
+	return nil
+}
+
+// rpcObject accepts results that are json objects, rejecting null and
+// error strings
+func rpcObject(raw []byte) bool {
+	raw = bytes.TrimSpace(raw)
+	return len(raw) > 0 && raw[0] == '{'
+}
@@ -1,3561 +0,0 @@
-package main
-
-import (
-	"encoding/json"
-	"fmt"
-	"io/ioutil"
-	"net/http"
-	"time"
-
-	"go.uber.org/ratelimit"
-)
-
-const BTC_URL = "https://blockchain.info/%s/%s"
-const BTC_LIMIT = 5
-
-type BTC struct {
-	limit ratelimit.Limiter
-}
-
-func NewBTC() *BTC {
-	b := new(BTC)
-	b.limit = ratelimit.New(BTC_LIMIT)
-	return b
-}
-
-func (b *BTC) call(method, hash string) ([]byte, error) {
-	b.limit.Take()
-
-	url := fmt.Sprintf(BTC_URL, method, hash)
-
-	req, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	resp, err := http.DefaultClient.Do(req)
-	if err != nil {
-		return nil, err
-	}
-
-	if resp.Body != nil {
-		defer resp.Body.Close()
-	}
-
-	return ioutil.ReadAll(resp.Body)
-}
-
-type btcresp struct {
-	Inputs []btcinput `json:"inputs"`
-	Out    []btcout   `json:"out"`
-	Time   int64      `json:"time"`
-	Hash   string     `json:"hash"`
-}
-
-type btcinput struct {
-	PrevOut btcout `json:"prev_out"`
-}
-
-type btcout struct {
-	Spent  bool   `json:"spent"`
-	Value  uint64 `json:"value"`
-	Script string `json:"script"`
-}
-
-func (b *BTC) GetTX(txid string) (string, error) {
-	body, err := b.call("rawtx", txid)
-	if err != nil {
-		return "", err
-	}
-
-	res := btcresp{}
-	if err := json.Unmarshal(body, &res); err != nil {
-		return "", err
-	}
-
-	return time.Unix(res.Time, 0).Format("2006-01-02 15:04"), nil
-}
-
-type addressresp struct {
-	TXs []btcresp `json:"txs"`
-}
-
-func (b *BTC) GetAddr(addr string, offset int64) ([]btcresp, error) {
-	body, err := b.call("rawaddr", fmt.Sprintf("%s?offset=%d&limit=50", addr, offset))
-	if err != nil {
-		return nil, err
-	}
-	//body := testresp
-
-	res := addressresp{}
-	if err := json.Unmarshal(body, &res); err != nil {
-		return nil, err
-	}
-
-	return res.TXs, nil
-}
-
-var testresp []byte = []byte(`{
-    "hash160":"c5b7fd920dce5f61934e792c7e6fcc829aff533d",
-    "address":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-    "n_tx":255243,
-    "total_received":378381856741,
-    "total_sent":378381854896,
-    "final_balance":1845,
-    "txs":[
-
-
-
-
-
-
-{
-   "ver":2,
-   "inputs":[
-      {
-         "sequence":4294967295,
-         "witness":"",
-         "prev_out":{
-            "spent":true,
-            "spending_outpoints":[
-               {
-                  "tx_index":0,
-                  "n":0
-               }
-            ],
-            "tx_index":0,
-            "type":0,
-            "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-            "value":4275,
-            "n":0,
-            "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-         },
-         "script":"4730440220410792dcea33a137941812c34d0f4608f12a4be94fec27b149bb34011351ba610220246f9cbc9010fec66a0cccc18b8533fe7162561a639937554b9616d41993cafa0121027e706cd1c919431b693a0247e4a239e632659a8723a621a91ec610c64f4173ac"
-      }
-   ],
-   "weight":968,
-   "block_height":643324,
-   "relayed_by":"0.0.0.0",
-   "out":[
-      {
-         "spent":false,
-         "tx_index":0,
-         "type":0,
-         "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-         "value":1845,
-         "n":0,
-         "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-      },
-      {
-         "spent":false,
-         "tx_index":0,
-         "type":0,
-         "value":0,
-         "n":1,
-         "script":"6a28466100000003eebbbe5a5e36d029fe5dad88394dd53539df09465fba9c07140630162ba47aaa37ba"
-      }
-   ],
-   "lock_time":0,
-   "result":-2430,
-   "size":242,
-   "block_index":0,
-   "time":1597207885,
-   "tx_index":0,
-   "vin_sz":1,
-   "hash":"067aca7c670f616df0828c133fcc4de729413b59ca97b0080501c6d3d4fa980a",
-   "vout_sz":2
-},
-
-
-
-
-
-
-{
-   "ver":2,
-   "inputs":[
-      {
-         "sequence":4294967295,
-         "witness":"",
-         "prev_out":{
-            "spent":true,
-            "spending_outpoints":[
-               {
-                  "tx_index":0,
-                  "n":0
-               }
-            ],
-            "tx_index":0,
-            "type":0,
-            "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-            "value":6705,
-            "n":0,
-            "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-         },
-         "script":"47304402201b42144b5f6ae6456af9cda85f9546247b9aad90d9a86b82534fb910cc1d432602203bf3a5372c6a86f4b8dd9babd7a0eb852a6519cd314c52bfe3aa402b26cc08b80121027e706cd1c919431b693a0247e4a239e632659a8723a621a91ec610c64f4173ac"
-      }
-   ],
-   "weight":968,
-   "block_height":643323,
-   "relayed_by":"0.0.0.0",
-   "out":[
-      {
-         "spent":true,
-         "spending_outpoints":[
-            {
-               "tx_index":0,
-               "n":0
-            }
-         ],
-         "tx_index":0,
-         "type":0,
-         "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-         "value":4275,
-         "n":0,
-         "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-      },
-      {
-         "spent":false,
-         "tx_index":0,
-         "type":0,
-         "value":0,
-         "n":1,
-         "script":"6a28466100000003d631e23e1a314d2705a0166609cc5c9a5707abf3145535df2d3148e485073f77d94a"
-      }
-   ],
-   "lock_time":0,
-   "result":-2430,
-   "size":242,
-   "block_index":0,
-   "time":1597207546,
-   "tx_index":0,
-   "vin_sz":1,
-   "hash":"e91e6e2f3e7b268f963205cdfd6504d69469a1885ec88ac3a4242519d780db4d",
-   "vout_sz":2
-},
-
-
-
-
-
-
-{
-   "ver":2,
-   "inputs":[
-      {
-         "sequence":4294967295,
-         "witness":"",
-         "prev_out":{
-            "spent":true,
-            "spending_outpoints":[
-               {
-                  "tx_index":0,
-                  "n":0
-               }
-            ],
-            "tx_index":0,
-            "type":0,
-            "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-            "value":9135,
-            "n":0,
-            "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-         },
-         "script":"47304402206462434479a5436113faae46069bb688e80b497826b383faa7235a1080f3eec2022060d3b37a69f37198f765f9a3e3000dbf0554af7ce8cb6c3b8f77e2dbd54627030121027e706cd1c919431b693a0247e4a239e632659a8723a621a91ec610c64f4173ac"
-      }
-   ],
-   "weight":968,
-   "block_height":643322,
-   "relayed_by":"0.0.0.0",
-   "out":[
-      {
-         "spent":true,
-         "spending_outpoints":[
-            {
-               "tx_index":0,
-               "n":0
-            }
-         ],
-         "tx_index":0,
-         "type":0,
-         "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-         "value":6705,
-         "n":0,
-         "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-      },
-      {
-         "spent":false,
-         "tx_index":0,
-         "type":0,
-         "value":0,
-         "n":1,
-         "script":"6a28466100000003d6301ee9f5dd934de7f6b496e2351fee91214a6a64ed127b54e38f4dda8b8844432e"
-      }
-   ],
-   "lock_time":0,
-   "result":-2430,
-   "size":242,
-   "block_index":0,
-   "time":1597206947,
-   "tx_index":0,
-   "vin_sz":1,
-   "hash":"fae1f676b3efc3b728c789e9e0ac5351708c6c88e603fadd642acb7062c634c1",
-   "vout_sz":2
-},
-
-
-
-
-
-
-{
-   "ver":2,
-   "inputs":[
-      {
-         "sequence":4294967295,
-         "witness":"",
-         "prev_out":{
-            "spent":true,
-            "spending_outpoints":[
-               {
-                  "tx_index":0,
-                  "n":0
-               }
-            ],
-            "tx_index":0,
-            "type":0,
-            "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-            "value":11565,
-            "n":0,
-            "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-         },
-         "script":"4730440220380e2322250bfb0137a4399bb4f6b4f25a4d13a4aa539470fcdb279fc49c500e0220175a89a4e2b762ed536f93299b4a8df2a87b61a8b20b465d627c36d90486dd980121027e706cd1c919431b693a0247e4a239e632659a8723a621a91ec610c64f4173ac"
-      }
-   ],
-   "weight":968,
-   "block_height":643320,
-   "relayed_by":"0.0.0.0",
-   "out":[
-      {
-         "spent":true,
-         "spending_outpoints":[
-            {
-               "tx_index":0,
-               "n":0
-            }
-         ],
-         "tx_index":0,
-         "type":0,
-         "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-         "value":9135,
-         "n":0,
-         "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-      },
-      {
-         "spent":false,
-         "tx_index":0,
-         "type":0,
-         "value":0,
-         "n":1,
-         "script":"6a28466100000003d62ffd1db89c3b2f1d27a790b72938421d519f3d829bcdf203dbbf7c560ab5295fc4"
-      }
-   ],
-   "lock_time":0,
-   "result":-2430,
-   "size":242,
-   "block_index":0,
-   "time":1597206249,
-   "tx_index":0,
-   "vin_sz":1,
-   "hash":"57afaf2cab721c53f8fdc417c5ff80de2e6d94067cf4eb2affa02bc7ac7d83da",
-   "vout_sz":2
-},
-
-
-
-
-
-
-{
-   "ver":2,
-   "inputs":[
-      {
-         "sequence":4294967295,
-         "witness":"",
-         "prev_out":{
-            "spent":true,
-            "spending_outpoints":[
-               {
-                  "tx_index":0,
-                  "n":0
-               }
-            ],
-            "tx_index":0,
-            "type":0,
-            "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-            "value":13995,
-            "n":0,
-            "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-         },
-         "script":"473044022077633d5c11b8902f5c8454c40e166817816e20b7da4418d0558635d4181b148902207a29081bf504aad28a72a6e752289df11dd9cb9d8c92f0872943aed7fae9585a0121027e706cd1c919431b693a0247e4a239e632659a8723a621a91ec610c64f4173ac"
-      }
-   ],
-   "weight":968,
-   "block_height":643319,
-   "relayed_by":"0.0.0.0",
-   "out":[
-      {
-         "spent":true,
-         "spending_outpoints":[
-            {
-               "tx_index":0,
-               "n":0
-            }
-         ],
-         "tx_index":0,
-         "type":0,
-         "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-         "value":11565,
-         "n":0,
-         "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-      },
-      {
-         "spent":false,
-         "tx_index":0,
-         "type":0,
-         "value":0,
-         "n":1,
-         "script":"6a28466100000003eeb8ba185460f18c45d356ec451db126bbb7863617da40f966a48d63af780ca491d6"
-      }
-   ],
-   "lock_time":0,
-   "result":-2430,
-   "size":242,
-   "block_index":0,
-   "time":1597205852,
-   "tx_index":0,
-   "vin_sz":1,
-   "hash":"2734d3c921a59f1748cd8a72e224f22cad4f878f3aad0ab062b48c8b059d2b03",
-   "vout_sz":2
-},
-
-
-
-
-
-
-{
-   "ver":2,
-   "inputs":[
-      {
-         "sequence":4294967295,
-         "witness":"",
-         "prev_out":{
-            "spent":true,
-            "spending_outpoints":[
-               {
-                  "tx_index":0,
-                  "n":0
-               }
-            ],
-            "tx_index":0,
-            "type":0,
-            "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-            "value":5665,
-            "n":0,
-            "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-         },
-         "script":"47304402207369938f1aa1a3a5c5182d86fff591271e4a7eef2ae20ae4e816ec763321046102207352ea32cad15d3b849de82e9c207d3d24da0a7b778cead6d0fd27e25d66b0120121027e706cd1c919431b693a0247e4a239e632659a8723a621a91ec610c64f4173ac"
-      },
-      {
-         "sequence":4294967295,
-         "witness":"",
-         "prev_out":{
-            "spent":true,
-            "spending_outpoints":[
-               {
-                  "tx_index":0,
-                  "n":0
-               }
-            ],
-            "tx_index":0,
-            "type":0,
-            "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-            "value":12220,
-            "n":0,
-            "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-         },
-         "script":"47304402206185cfbc863f9dbfedfd2f0d26d6605dd49cf7fd9dc5ab6cd462284aa01da98a02204b04c91bed1a3192f5ef1566266f5c987887ecece45482eae2dcb4f0731ecf930121027e706cd1c919431b693a0247e4a239e632659a8723a621a91ec610c64f4173ac"
-      }
-   ],
-   "weight":1556,
-   "block_height":643318,
-   "relayed_by":"0.0.0.0",
-   "out":[
-      {
-         "spent":true,
-         "spending_outpoints":[
-            {
-               "tx_index":0,
-               "n":0
-            }
-         ],
-         "tx_index":0,
-         "type":0,
-         "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-         "value":13995,
-         "n":0,
-         "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-      },
-      {
-         "spent":false,
-         "tx_index":0,
-         "type":0,
-         "value":0,
-         "n":1,
-         "script":"6a28466100000003d62e6a0fd04db30e8d75451825cf4aa33ac49ea50cbbbc9dcd209a4e2682ef6aa1c6"
-      }
-   ],
-   "lock_time":0,
-   "result":-3890,
-   "size":389,
-   "block_index":0,
-   "time":1597205798,
-   "tx_index":0,
-   "vin_sz":2,
-   "hash":"32e1a090498533998023e3d19fcc64870ad380a1c6b3c2aa53d0f5eaa0ba23c7",
-   "vout_sz":2
-},
-
-
-
-
-
-
-{
-   "ver":2,
-   "inputs":[
-      {
-         "sequence":4294967295,
-         "witness":"",
-         "prev_out":{
-            "spent":true,
-            "spending_outpoints":[
-               {
-                  "tx_index":0,
-                  "n":0
-               }
-            ],
-            "tx_index":0,
-            "type":0,
-            "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-            "value":14650,
-            "n":0,
-            "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-         },
-         "script":"4730440220572dd8b679d4d2b02581f00a969e04140d04c58bdd7a0397101dfa00cad67a5302203e099871e07ee1a1998ae10c64662a6f7c4457c723106743480c2d03aa0482ec0121027e706cd1c919431b693a0247e4a239e632659a8723a621a91ec610c64f4173ac"
-      }
-   ],
-   "weight":968,
-   "block_height":643317,
-   "relayed_by":"0.0.0.0",
-   "out":[
-      {
-         "spent":true,
-         "spending_outpoints":[
-            {
-               "tx_index":0,
-               "n":1
-            }
-         ],
-         "tx_index":0,
-         "type":0,
-         "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-         "value":12220,
-         "n":0,
-         "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-      },
-      {
-         "spent":false,
-         "tx_index":0,
-         "type":0,
-         "value":0,
-         "n":1,
-         "script":"6a28466100000003eeb718fd5647e693605a49dea8a313e842c6b0b456f2d7749fdfe096c8f42e02c0fc"
-      }
-   ],
-   "lock_time":0,
-   "result":-2430,
-   "size":242,
-   "block_index":0,
-   "time":1597205357,
-   "tx_index":0,
-   "vin_sz":1,
-   "hash":"1db77736040707037182355161b69c9228ae0057da20af445ffbe2d13b5e4785",
-   "vout_sz":2
-},
-
-
-
-
-
-
-{
-   "ver":2,
-   "inputs":[
-      {
-         "sequence":4294967295,
-         "witness":"",
-         "prev_out":{
-            "spent":true,
-            "spending_outpoints":[
-               {
-                  "tx_index":0,
-                  "n":0
-               }
-            ],
-            "tx_index":0,
-            "type":0,
-            "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-            "value":8095,
-            "n":0,
-            "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-         },
-         "script":"4730440220235dacc9be51e9e6946d962adee627acfd122dbbf74491090bd5324dd46beb2302203f4de85ff9e0fae406989ab30bb3d057ff7756ec77704e2f9886aff91590ebf30121027e706cd1c919431b693a0247e4a239e632659a8723a621a91ec610c64f4173ac"
-      }
-   ],
-   "weight":968,
-   "block_height":643317,
-   "relayed_by":"0.0.0.0",
-   "out":[
-      {
-         "spent":true,
-         "spending_outpoints":[
-            {
-               "tx_index":0,
-               "n":0
-            }
-         ],
-         "tx_index":0,
-         "type":0,
-         "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-         "value":5665,
-         "n":0,
-         "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-      },
-      {
-         "spent":false,
-         "tx_index":0,
-         "type":0,
-         "value":0,
-         "n":1,
-         "script":"6a28466100000003d62dfe9fb1e34260ed48d6b9f0b838076b70227f5e76ae71942b8a0697fd6cf9f1b3"
-      }
-   ],
-   "lock_time":0,
-   "result":-2430,
-   "size":242,
-   "block_index":0,
-   "time":1597205369,
-   "tx_index":0,
-   "vin_sz":1,
-   "hash":"eca563a23347c49db456a018f32bc0e4f6810c77d2ce8ac94d8b7a9313a8391d",
-   "vout_sz":2
-},
-
-
-
-
-
-
-{
-   "ver":2,
-   "inputs":[
-      {
-         "sequence":4294967295,
-         "witness":"",
-         "prev_out":{
-            "spent":true,
-            "spending_outpoints":[
-               {
-                  "tx_index":0,
-                  "n":0
-               }
-            ],
-            "tx_index":0,
-            "type":0,
-            "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-            "value":10525,
-            "n":0,
-            "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-         },
-         "script":"473044022002674868c4503cf9438d22db5bc5155e4f23745acd124ef97db315befeb4226102205912fabc9e893fb53dd47c1e823b2132a4c091993e15d967f28240b2d731022b0121027e706cd1c919431b693a0247e4a239e632659a8723a621a91ec610c64f4173ac"
-      }
-   ],
-   "weight":968,
-   "block_height":643316,
-   "relayed_by":"0.0.0.0",
-   "out":[
-      {
-         "spent":true,
-         "spending_outpoints":[
-            {
-               "tx_index":0,
-               "n":0
-            }
-         ],
-         "tx_index":0,
-         "type":0,
-         "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-         "value":8095,
-         "n":0,
-         "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-      },
-      {
-         "spent":false,
-         "tx_index":0,
-         "type":0,
-         "value":0,
-         "n":1,
-         "script":"6a28466100000003d62b76c95d618734cada13b815a8514110b0a8fb9b3bc33b3ea3da6d137999e32fef"
-      }
-   ],
-   "lock_time":0,
-   "result":-2430,
-   "size":242,
-   "block_index":0,
-   "time":1597205063,
-   "tx_index":0,
-   "vin_sz":1,
-   "hash":"7d89a047c2fd46af2cf7c2cce0db244aeae972ab6080c3057c0614212dad5fdd",
-   "vout_sz":2
-},
-
-
-
-
-
-
-{
-   "ver":2,
-   "inputs":[
-      {
-         "sequence":4294967295,
-         "witness":"",
-         "prev_out":{
-            "spent":true,
-            "spending_outpoints":[
-               {
-                  "tx_index":0,
-                  "n":0
-               }
-            ],
-            "tx_index":0,
-            "type":0,
-            "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-            "value":17080,
-            "n":0,
-            "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-         },
-         "script":"473044022053a2633d724987e128931e0e85b560fed707619801957b29a25f096c44fc2ca102204f6f85c632aa82d0d9fe3065d14335a2c8ce4c5dc1f62707e20c1f01d2797ad20121027e706cd1c919431b693a0247e4a239e632659a8723a621a91ec610c64f4173ac"
-      }
-   ],
-   "weight":968,
-   "block_height":643316,
-   "relayed_by":"0.0.0.0",
-   "out":[
-      {
-         "spent":true,
-         "spending_outpoints":[
-            {
-               "tx_index":0,
-               "n":0
-            }
-         ],
-         "tx_index":0,
-         "type":0,
-         "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-         "value":14650,
-         "n":0,
-         "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-      },
-      {
-         "spent":false,
-         "tx_index":0,
-         "type":0,
-         "value":0,
-         "n":1,
-         "script":"6a28466100000003d62cbb9632984f3c8d40895e807304a13e873ac8a5361666dfc39011de22be28209e"
-      }
-   ],
-   "lock_time":0,
-   "result":-2430,
-   "size":242,
-   "block_index":0,
-   "time":1597205069,
-   "tx_index":0,
-   "vin_sz":1,
-   "hash":"ab5443d1896608ae3d97528c74648358d313c984b9fcb13e9fd4d79df8059132",
-   "vout_sz":2
-},
-
-
-
-
-
-
-{
-   "ver":2,
-   "inputs":[
-      {
-         "sequence":4294967295,
-         "witness":"",
-         "prev_out":{
-            "spent":true,
-            "spending_outpoints":[
-               {
-                  "tx_index":0,
-                  "n":0
-               }
-            ],
-            "tx_index":0,
-            "type":0,
-            "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-            "value":19510,
-            "n":0,
-            "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-         },
-         "script":"47304402207cb140eed36bca224ba7b7e876188d973745a0a2b6e2c20fe9277e018ce8b9e502200a520cf420686363b931ad65087ec87abc4b34c928c448cf4d79c44af2a0fe3a0121027e706cd1c919431b693a0247e4a239e632659a8723a621a91ec610c64f4173ac"
-      }
-   ],
-   "weight":968,
-   "block_height":643315,
-   "relayed_by":"0.0.0.0",
-   "out":[
-      {
-         "spent":true,
-         "spending_outpoints":[
-            {
-               "tx_index":0,
-               "n":0
-            }
-         ],
-         "tx_index":0,
-         "type":0,
-         "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-         "value":17080,
-         "n":0,
-         "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-      },
-      {
-         "spent":false,
-         "tx_index":0,
-         "type":0,
-         "value":0,
-         "n":1,
-         "script":"6a28466100000003d62afdf7133c648b8e2ccd6c0a38cf2290695c3a828a897e4db9e1120f8581b38a50"
-      }
-   ],
-   "lock_time":0,
-   "result":-2430,
-   "size":242,
-   "block_index":0,
-   "time":1597204401,
-   "tx_index":0,
-   "vin_sz":1,
-   "hash":"088a4a8c2ed13678d8c8421986c4d9892acc8b08c5cae29aca44dd2a2dc936e4",
-   "vout_sz":2
-},
-
-
-
-
-
-
-{
-   "ver":2,
-   "inputs":[
-      {
-         "sequence":4294967295,
-         "witness":"",
-         "prev_out":{
-            "spent":true,
-            "spending_outpoints":[
-               {
-                  "tx_index":0,
-                  "n":0
-               }
-            ],
-            "tx_index":0,
-            "type":0,
-            "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-            "value":12955,
-            "n":0,
-            "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-         },
-         "script":"473044022060702971621a606ddce8c4e3605115882f065415c3adc49a575bea6cc863bb0b022036eaba6c70eb7657e0782294cd71ac9754b4c7f796ddd6a4dac48407d217f6970121027e706cd1c919431b693a0247e4a239e632659a8723a621a91ec610c64f4173ac"
-      }
-   ],
-   "weight":968,
-   "block_height":643315,
-   "relayed_by":"0.0.0.0",
-   "out":[
-      {
-         "spent":true,
-         "spending_outpoints":[
-            {
-               "tx_index":0,
-               "n":0
-            }
-         ],
-         "tx_index":0,
-         "type":0,
-         "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-         "value":10525,
-         "n":0,
-         "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-      },
-      {
-         "spent":false,
-         "tx_index":0,
-         "type":0,
-         "value":0,
-         "n":1,
-         "script":"6a28466100000003eeb6dd011b1ea16b7c94482bd7a9754af7f62c2029bf81d480fc6a86672af67ed650"
-      }
-   ],
-   "lock_time":0,
-   "result":-2430,
-   "size":242,
-   "block_index":0,
-   "time":1597204364,
-   "tx_index":0,
-   "vin_sz":1,
-   "hash":"0e283c3a1b8be1017e5fa0bbb3f4bce0e32df86aa9fe6de771c45ddd270a3052",
-   "vout_sz":2
-},
-
-
-
-
-
-
-{
-   "ver":2,
-   "inputs":[
-      {
-         "sequence":4294967295,
-         "witness":"",
-         "prev_out":{
-            "spent":true,
-            "spending_outpoints":[
-               {
-                  "tx_index":0,
-                  "n":0
-               }
-            ],
-            "tx_index":0,
-            "type":0,
-            "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-            "value":21940,
-            "n":0,
-            "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-         },
-         "script":"47304402202681ffb2b084ddc609d226f26c8610ef86d567bf32060d643b690a79cdfa307f02206585276782cea66a42dd38daeb47ce01f06bf4e4aa896ec3a1311a9c6234badf0121027e706cd1c919431b693a0247e4a239e632659a8723a621a91ec610c64f4173ac"
-      }
-   ],
-   "weight":968,
-   "block_height":643314,
-   "relayed_by":"0.0.0.0",
-   "out":[
-      {
-         "spent":true,
-         "spending_outpoints":[
-            {
-               "tx_index":0,
-               "n":0
-            }
-         ],
-         "tx_index":0,
-         "type":0,
-         "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-         "value":19510,
-         "n":0,
-         "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-      },
-      {
-         "spent":false,
-         "tx_index":0,
-         "type":0,
-         "value":0,
-         "n":1,
-         "script":"6a28466100000003d6294f4cf04d08a23967f2e44e5cffae1579faceb9f2180fc0f73eb2cc9939697765"
-      }
-   ],
-   "lock_time":0,
-   "result":-2430,
-   "size":242,
-   "block_index":0,
-   "time":1597201560,
-   "tx_index":0,
-   "vin_sz":1,
-   "hash":"1ad03fb2a2cbde0a1367181ffb781469988ba0daa6809041bdb3c38f6e4021da",
-   "vout_sz":2
-},
-
-
-
-
-
-
-{
-   "ver":2,
-   "inputs":[
-      {
-         "sequence":4294967295,
-         "witness":"",
-         "prev_out":{
-            "spent":true,
-            "spending_outpoints":[
-               {
-                  "tx_index":0,
-                  "n":0
-               }
-            ],
-            "tx_index":0,
-            "type":0,
-            "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-            "value":15385,
-            "n":0,
-            "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-         },
-         "script":"47304402201d99d0753e8a390e4ac5abead63053c733d518a844bd5fc66ce0893aae8c022a02205df0f02be0f35be7f1a51189bf83a402d9c2b695265ca6ad290225da9934ddf50121027e706cd1c919431b693a0247e4a239e632659a8723a621a91ec610c64f4173ac"
-      }
-   ],
-   "weight":968,
-   "block_height":643314,
-   "relayed_by":"0.0.0.0",
-   "out":[
-      {
-         "spent":true,
-         "spending_outpoints":[
-            {
-               "tx_index":0,
-               "n":0
-            }
-         ],
-         "tx_index":0,
-         "type":0,
-         "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-         "value":12955,
-         "n":0,
-         "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-      },
-      {
-         "spent":false,
-         "tx_index":0,
-         "type":0,
-         "value":0,
-         "n":1,
-         "script":"6a28466100000003eeb11ec8578fb20a5f4bd6b65c95b01f47b4e801ade31464c3a9c212da54a82409c7"
-      }
-   ],
-   "lock_time":0,
-   "result":-2430,
-   "size":242,
-   "block_index":0,
-   "time":1597201552,
-   "tx_index":0,
-   "vin_sz":1,
-   "hash":"f59825145601b90f0791863d9f108561feedc53e156b1ffe886657e5f4c8035a",
-   "vout_sz":2
-},
-
-
-
-
-
-
-{
-   "ver":2,
-   "inputs":[
-      {
-         "sequence":4294967295,
-         "witness":"",
-         "prev_out":{
-            "spent":true,
-            "spending_outpoints":[
-               {
-                  "tx_index":0,
-                  "n":0
-               }
-            ],
-            "tx_index":0,
-            "type":0,
-            "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-            "value":24370,
-            "n":0,
-            "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-         },
-         "script":"47304402206cc5a5e59c9f88506a7a5aa430e0ea7568a4cf2d3af5a7135e41548db0991be702204a35f7b6a1f276371f55c73c709c594f47a03a747aa42a3bdd7076166cfaa2010121027e706cd1c919431b693a0247e4a239e632659a8723a621a91ec610c64f4173ac"
-      }
-   ],
-   "weight":968,
-   "block_height":643311,
-   "relayed_by":"0.0.0.0",
-   "out":[
-      {
-         "spent":true,
-         "spending_outpoints":[
-            {
-               "tx_index":0,
-               "n":0
-            }
-         ],
-         "tx_index":0,
-         "type":0,
-         "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-         "value":21940,
-         "n":0,
-         "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-      },
-      {
-         "spent":false,
-         "tx_index":0,
-         "type":0,
-         "value":0,
-         "n":1,
-         "script":"6a28466100000003eeb0e1b8c80d313da37ba5b0b1e99aeaf7a2cb160d2f8e5d1a52a6550cedb7df6c0f"
-      }
-   ],
-   "lock_time":0,
-   "result":-2430,
-   "size":242,
-   "block_index":0,
-   "time":1597201274,
-   "tx_index":0,
-   "vin_sz":1,
-   "hash":"1c69b2fb0201ac36d4a109c1a1a6c3b6ab73eb2a7f60792f1117d75e203fca42",
-   "vout_sz":2
-},
-
-
-
-
-
-
-{
-   "ver":2,
-   "inputs":[
-      {
-         "sequence":4294967295,
-         "witness":"",
-         "prev_out":{
-            "spent":true,
-            "spending_outpoints":[
-               {
-                  "tx_index":0,
-                  "n":0
-               }
-            ],
-            "tx_index":0,
-            "type":0,
-            "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-            "value":17815,
-            "n":0,
-            "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-         },
-         "script":"473044022052598a4cded44ddc7ba834da6603bc304657986913af959f6535a5a617f5d0b1022049210e0d2748e23870db4e18bf0ad4ad502ebf9cfea2001da5ae46bdf0f9a4960121027e706cd1c919431b693a0247e4a239e632659a8723a621a91ec610c64f4173ac"
-      }
-   ],
-   "weight":968,
-   "block_height":643311,
-   "relayed_by":"0.0.0.0",
-   "out":[
-      {
-         "spent":true,
-         "spending_outpoints":[
-            {
-               "tx_index":0,
-               "n":0
-            }
-         ],
-         "tx_index":0,
-         "type":0,
-         "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-         "value":15385,
-         "n":0,
-         "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-      },
-      {
-         "spent":false,
-         "tx_index":0,
-         "type":0,
-         "value":0,
-         "n":1,
-         "script":"6a28466100000003d628d1810398aed1bd1a0b4ee5c9d777047283d563828ddfdd6a17d09a95c96a05f0"
-      }
-   ],
-   "lock_time":0,
-   "result":-2430,
-   "size":242,
-   "block_index":0,
-   "time":1597201305,
-   "tx_index":0,
-   "vin_sz":1,
-   "hash":"b7c29fd2fb1e64b05ebc230165c24c8f44a74421c34619fd5ea266dcc13da705",
-   "vout_sz":2
-},
-
-
-
-
-
-
-{
-   "ver":2,
-   "inputs":[
-      {
-         "sequence":4294967295,
-         "witness":"",
-         "prev_out":{
-            "spent":true,
-            "spending_outpoints":[
-               {
-                  "tx_index":0,
-                  "n":0
-               }
-            ],
-            "tx_index":0,
-            "type":0,
-            "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-            "value":20245,
-            "n":0,
-            "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-         },
-         "script":"47304402205c316a217beeb223c9d8aa9e8e5ca1c2e2c6ca22f1559d4214dd386ffd7194f2022007c0d5c8f703387c40137a7f56cfb96ceba27f4bd6c3b784dd60500f63b96eb40121027e706cd1c919431b693a0247e4a239e632659a8723a621a91ec610c64f4173ac"
-      }
-   ],
-   "weight":968,
-   "block_height":643309,
-   "relayed_by":"0.0.0.0",
-   "out":[
-      {
-         "spent":true,
-         "spending_outpoints":[
-            {
-               "tx_index":0,
-               "n":0
-            }
-         ],
-         "tx_index":0,
-         "type":0,
-         "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-         "value":17815,
-         "n":0,
-         "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-      },
-      {
-         "spent":false,
-         "tx_index":0,
-         "type":0,
-         "value":0,
-         "n":1,
-         "script":"6a28466100000003d627dfbc6daeb9258a2603ba48cc33c8e629fde7b1005b0fed8c58ef977c0577ce51"
-      }
-   ],
-   "lock_time":0,
-   "result":-2430,
-   "size":242,
-   "block_index":0,
-   "time":1597199205,
-   "tx_index":0,
-   "vin_sz":1,
-   "hash":"013174f4da44f62da1cb3f411446c450923a19f4f35c54b6337e0d86616dabf0",
-   "vout_sz":2
-},
-
-
-
-
-
-
-{
-   "ver":2,
-   "inputs":[
-      {
-         "sequence":4294967295,
-         "witness":"",
-         "prev_out":{
-            "spent":true,
-            "spending_outpoints":[
-               {
-                  "tx_index":0,
-                  "n":0
-               }
-            ],
-            "tx_index":0,
-            "type":0,
-            "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-            "value":26800,
-            "n":0,
-            "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-         },
-         "script":"473044022041758ca03ba381b328e161e2a13ea81d010a47ca8bb1ed7a33595358c80ffd26022069992e006af01266bde79db1d2602d652c872eda44c1551aa3305631ff58fa450121027e706cd1c919431b693a0247e4a239e632659a8723a621a91ec610c64f4173ac"
-      }
-   ],
-   "weight":968,
-   "block_height":643309,
-   "relayed_by":"0.0.0.0",
-   "out":[
-      {
-         "spent":true,
-         "spending_outpoints":[
-            {
-               "tx_index":0,
-               "n":0
-            }
-         ],
-         "tx_index":0,
-         "type":0,
-         "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-         "value":24370,
-         "n":0,
-         "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-      },
-      {
-         "spent":false,
-         "tx_index":0,
-         "type":0,
-         "value":0,
-         "n":1,
-         "script":"6a28466100000003d626e77b52263958d858f0a553137f4dc49794a6ed27e54bcc5e7aff6c765e2289d6"
-      }
-   ],
-   "lock_time":0,
-   "result":-2430,
-   "size":242,
-   "block_index":0,
-   "time":1597199197,
-   "tx_index":0,
-   "vin_sz":1,
-   "hash":"e88322d237815ffe37cbdb3777b87f392a35a5c9f74bb1f81c0ee2a45df09f87",
-   "vout_sz":2
-},
-
-
-
-
-
-
-{
-   "ver":2,
-   "inputs":[
-      {
-         "sequence":4294967295,
-         "witness":"",
-         "prev_out":{
-            "spent":true,
-            "spending_outpoints":[
-               {
-                  "tx_index":0,
-                  "n":0
-               }
-            ],
-            "tx_index":0,
-            "type":0,
-            "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-            "value":22675,
-            "n":0,
-            "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-         },
-         "script":"4730440220546b41f2972095f95f0656caf05a4865cfc907949d3a36d6e8afa34073cb670f0220224d5899913adbf1e0319ef8d28506b9f10b89a492c85dbbc7b8c651ec59d2020121027e706cd1c919431b693a0247e4a239e632659a8723a621a91ec610c64f4173ac"
-      }
-   ],
-   "weight":968,
-   "block_height":643307,
-   "relayed_by":"0.0.0.0",
-   "out":[
-      {
-         "spent":true,
-         "spending_outpoints":[
-            {
-               "tx_index":0,
-               "n":0
-            }
-         ],
-         "tx_index":0,
-         "type":0,
-         "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-         "value":20245,
-         "n":0,
-         "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-      },
-      {
-         "spent":false,
-         "tx_index":0,
-         "type":0,
-         "value":0,
-         "n":1,
-         "script":"6a28466100000003eeac22768b0618d2e6693db85515f470ace6115cd0d00d2fdc0dc5a12cef900d79ce"
-      }
-   ],
-   "lock_time":0,
-   "result":-2430,
-   "size":242,
-   "block_index":0,
-   "time":1597198578,
-   "tx_index":0,
-   "vin_sz":1,
-   "hash":"81e0ca6b7aa1a530c2f88a0dd2e5df541199e24f190c3ccf8f29f834a6c5f8a1",
-   "vout_sz":2
-},
-
-
-
-
-
-
-{
-   "ver":2,
-   "inputs":[
-      {
-         "sequence":4294967295,
-         "witness":"",
-         "prev_out":{
-            "spent":true,
-            "spending_outpoints":[
-               {
-                  "tx_index":0,
-                  "n":0
-               }
-            ],
-            "tx_index":0,
-            "type":0,
-            "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-            "value":29230,
-            "n":0,
-            "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-         },
-         "script":"473044022071d273f081d80f28edf88a2fd43dbbc5e11125c7f935204339e7a5c07a29cb7b02202074ac9ad85f52713159115b2d3275cee44c25a027d3df265e3b16b6b73fb80b0121027e706cd1c919431b693a0247e4a239e632659a8723a621a91ec610c64f4173ac"
-      }
-   ],
-   "weight":968,
-   "block_height":643307,
-   "relayed_by":"0.0.0.0",
-   "out":[
-      {
-         "spent":true,
-         "spending_outpoints":[
-            {
-               "tx_index":0,
-               "n":0
-            }
-         ],
-         "tx_index":0,
-         "type":0,
-         "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-         "value":26800,
-         "n":0,
-         "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-      },
-      {
-         "spent":false,
-         "tx_index":0,
-         "type":0,
-         "value":0,
-         "n":1,
-         "script":"6a28466100000003d6251fb39c1c50b41166bf39647876ca4e42572a8dc930e5647d0ac112f9ea962020"
-      }
-   ],
-   "lock_time":0,
-   "result":-2430,
-   "size":242,
-   "block_index":0,
-   "time":1597198605,
-   "tx_index":0,
-   "vin_sz":1,
-   "hash":"36e7d7e74aaa75c7b55dd956059cfb300f076f38f2d5785907e9fcd1d96d4515",
-   "vout_sz":2
-},
-
-
-
-
-
-
-{
-   "ver":2,
-   "inputs":[
-      {
-         "sequence":4294967295,
-         "witness":"",
-         "prev_out":{
-            "spent":true,
-            "spending_outpoints":[
-               {
-                  "tx_index":0,
-                  "n":0
-               }
-            ],
-            "tx_index":0,
-            "type":0,
-            "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-            "value":31660,
-            "n":0,
-            "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-         },
-         "script":"47304402204bbf4e3d56e1c6dda1dd7aa31599df1143fe75c23a43257acb856fb905de7a37022029918dc63fe074abdf47f77b5b312c87f41a16c4c4dcf531b970c60a88b338320121027e706cd1c919431b693a0247e4a239e632659a8723a621a91ec610c64f4173ac"
-      }
-   ],
-   "weight":968,
-   "block_height":643306,
-   "relayed_by":"0.0.0.0",
-   "out":[
-      {
-         "spent":true,
-         "spending_outpoints":[
-            {
-               "tx_index":0,
-               "n":0
-            }
-         ],
-         "tx_index":0,
-         "type":0,
-         "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-         "value":29230,
-         "n":0,
-         "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-      },
-      {
-         "spent":false,
-         "tx_index":0,
-         "type":0,
-         "value":0,
-         "n":1,
-         "script":"6a28466100000003d624fb3d14669eb0e94081fe95b71420025912739b9c6681d8bbf75a461f4d842006"
-      }
-   ],
-   "lock_time":0,
-   "result":-2430,
-   "size":242,
-   "block_index":0,
-   "time":1597198362,
-   "tx_index":0,
-   "vin_sz":1,
-   "hash":"1c93235e5f09681d46b51b56676a8b5d5334bf9e6d566f4f4fb1051d9537fdd3",
-   "vout_sz":2
-},
-
-
-
-
-
-
-{
-   "ver":2,
-   "inputs":[
-      {
-         "sequence":4294967295,
-         "witness":"",
-         "prev_out":{
-            "spent":true,
-            "spending_outpoints":[
-               {
-                  "tx_index":0,
-                  "n":0
-               }
-            ],
-            "tx_index":0,
-            "type":0,
-            "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-            "value":25105,
-            "n":0,
-            "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-         },
-         "script":"47304402203573325eb37c2a7b415094ca45b553a181b55cefc764a0d01fc8955aec85e78702200689fa76246f53897a04c594027e65b2bbaf2f0c80b045f3de78f8a1d22acb100121027e706cd1c919431b693a0247e4a239e632659a8723a621a91ec610c64f4173ac"
-      }
-   ],
-   "weight":968,
-   "block_height":643306,
-   "relayed_by":"0.0.0.0",
-   "out":[
-      {
-         "spent":true,
-         "spending_outpoints":[
-            {
-               "tx_index":0,
-               "n":0
-            }
-         ],
-         "tx_index":0,
-         "type":0,
-         "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-         "value":22675,
-         "n":0,
-         "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-      },
-      {
-         "spent":false,
-         "tx_index":0,
-         "type":0,
-         "value":0,
-         "n":1,
-         "script":"6a28466100000003d6235ac903ed4cb610b81d3e6c93f7b23aa815aa00397986c94d67b7a30387567d56"
-      }
-   ],
-   "lock_time":0,
-   "result":-2430,
-   "size":242,
-   "block_index":0,
-   "time":1597198354,
-   "tx_index":0,
-   "vin_sz":1,
-   "hash":"1286fb621b445c09e420394a1285ea1d0bdcbb8e6d51d2b12ef50ccd02c53c88",
-   "vout_sz":2
-},
-
-
-
-
-
-
-{
-   "ver":2,
-   "inputs":[
-      {
-         "sequence":4294967295,
-         "witness":"",
-         "prev_out":{
-            "spent":true,
-            "spending_outpoints":[
-               {
-                  "tx_index":0,
-                  "n":0
-               }
-            ],
-            "tx_index":0,
-            "type":0,
-            "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-            "value":34090,
-            "n":0,
-            "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-         },
-         "script":"4730440220418cb6bb729e24311576af33f0573de594492ddc72dfb256e1146e4e27612957022017d7215561525084ed1b395f1f4d6af8149e9ab72e06975495c2e082144a30c30121027e706cd1c919431b693a0247e4a239e632659a8723a621a91ec610c64f4173ac"
-      }
-   ],
-   "weight":968,
-   "block_height":643305,
-   "relayed_by":"0.0.0.0",
-   "out":[
-      {
-         "spent":true,
-         "spending_outpoints":[
-            {
-               "tx_index":0,
-               "n":0
-            }
-         ],
-         "tx_index":0,
-         "type":0,
-         "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-         "value":31660,
-         "n":0,
-         "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-      },
-      {
-         "spent":false,
-         "tx_index":0,
-         "type":0,
-         "value":0,
-         "n":1,
-         "script":"6a28466100000003d6217e169bc1513be4f0340b274b353241e14ec068d071025c9d7675ec8a7a4e46b4"
-      }
-   ],
-   "lock_time":0,
-   "result":-2430,
-   "size":242,
-   "block_index":0,
-   "time":1597197664,
-   "tx_index":0,
-   "vin_sz":1,
-   "hash":"b5bbfc27f1c177674fc0b12e428bfc33c24ccf3b648b8b6e7eac0271be773e2d",
-   "vout_sz":2
-},
-
-
-
-
-
-
-{
-   "ver":2,
-   "inputs":[
-      {
-         "sequence":4294967295,
-         "witness":"",
-         "prev_out":{
-            "spent":true,
-            "spending_outpoints":[
-               {
-                  "tx_index":0,
-                  "n":0
-               }
-            ],
-            "tx_index":0,
-            "type":0,
-            "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-            "value":27535,
-            "n":0,
-            "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-         },
-         "script":"473044022074a7cbae76e424bccdfed5aef8d91631962272fa244389c0bc4a36cc05b29da5022063f6e197e1aba11a929dbab877ed35652a1be2a05391bfc0c6c121b3b2a2e6510121027e706cd1c919431b693a0247e4a239e632659a8723a621a91ec610c64f4173ac"
-      }
-   ],
-   "weight":968,
-   "block_height":643305,
-   "relayed_by":"0.0.0.0",
-   "out":[
-      {
-         "spent":true,
-         "spending_outpoints":[
-            {
-               "tx_index":0,
-               "n":0
-            }
-         ],
-         "tx_index":0,
-         "type":0,
-         "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-         "value":25105,
-         "n":0,
-         "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-      },
-      {
-         "spent":false,
-         "tx_index":0,
-         "type":0,
-         "value":0,
-         "n":1,
-         "script":"6a28466100000003d622238b3f36ea38e32066663992329aa21f30a28d86def524e3ae8224093cc439d5"
-      }
-   ],
-   "lock_time":0,
-   "result":-2430,
-   "size":242,
-   "block_index":0,
-   "time":1597197670,
-   "tx_index":0,
-   "vin_sz":1,
-   "hash":"8fbdd165a628c5e09ebe27bddcb14db850ec6909cbae0771c8ad0573a81f332b",
-   "vout_sz":2
-},
-
-
-
-
-
-
-{
-   "ver":2,
-   "inputs":[
-      {
-         "sequence":4294967295,
-         "witness":"",
-         "prev_out":{
-            "spent":true,
-            "spending_outpoints":[
-               {
-                  "tx_index":0,
-                  "n":0
-               }
-            ],
-            "tx_index":0,
-            "type":0,
-            "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-            "value":8888,
-            "n":0,
-            "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-         },
-         "script":"47304402201c837842fadbf50d6b8f71ec150c26fcfd4fcf708104059a0eb615f1eacad08e0220085e4f7e5799890bc0989b7fd4ceb7249f9a08221403dcc1b99a5e2b4b92d5750121027e706cd1c919431b693a0247e4a239e632659a8723a621a91ec610c64f4173ac"
-      },
-      {
-         "sequence":4294967295,
-         "witness":"",
-         "prev_out":{
-            "spent":true,
-            "spending_outpoints":[
-               {
-                  "tx_index":0,
-                  "n":0
-               }
-            ],
-            "tx_index":0,
-            "type":0,
-            "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-            "value":22537,
-            "n":0,
-            "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-         },
-         "script":"47304402202a92d841882cf0179ab428740bdb801d4880beb2ecad523bb8610eddb48d1a64022014222f2a25025ab48b1e805642102e9c38002d7ea6311d786593ea6eb56af9730121027e706cd1c919431b693a0247e4a239e632659a8723a621a91ec610c64f4173ac"
-      }
-   ],
-   "weight":1556,
-   "block_height":643304,
-   "relayed_by":"0.0.0.0",
-   "out":[
-      {
-         "spent":true,
-         "spending_outpoints":[
-            {
-               "tx_index":0,
-               "n":0
-            }
-         ],
-         "tx_index":0,
-         "type":0,
-         "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-         "value":27535,
-         "n":0,
-         "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-      },
-      {
-         "spent":false,
-         "tx_index":0,
-         "type":0,
-         "value":0,
-         "n":1,
-         "script":"6a28466100000003eeaa4faf504a6fa45b4f38746a5ab04d23c02d9e50a81e2ba21db903036b0cb9c867"
-      }
-   ],
-   "lock_time":0,
-   "result":-3890,
-   "size":389,
-   "block_index":0,
-   "time":1597197563,
-   "tx_index":0,
-   "vin_sz":2,
-   "hash":"7fc6c24dd2c1da60a474f312a4bb3605bb15b74057fd8003d847de3f5beb5bea",
-   "vout_sz":2
-},
-
-
-
-
-
-
-{
-   "ver":2,
-   "inputs":[
-      {
-         "sequence":4294967295,
-         "witness":"",
-         "prev_out":{
-            "spent":true,
-            "spending_outpoints":[
-               {
-                  "tx_index":0,
-                  "n":0
-               }
-            ],
-            "tx_index":0,
-            "type":0,
-            "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-            "value":36520,
-            "n":0,
-            "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-         },
-         "script":"4730440220319beceb658c7c6cca25df7952697a7db15ad895440a97666f63922a70a3164202201c96b4f4830c5aa168bb799854d56150d4b66bbb4386c3022a5287ed85b373e40121027e706cd1c919431b693a0247e4a239e632659a8723a621a91ec610c64f4173ac"
-      }
-   ],
-   "weight":968,
-   "block_height":643304,
-   "relayed_by":"0.0.0.0",
-   "out":[
-      {
-         "spent":true,
-         "spending_outpoints":[
-            {
-               "tx_index":0,
-               "n":0
-            }
-         ],
-         "tx_index":0,
-         "type":0,
-         "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-         "value":34090,
-         "n":0,
-         "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-      },
-      {
-         "spent":false,
-         "tx_index":0,
-         "type":0,
-         "value":0,
-         "n":1,
-         "script":"6a28466100000003d620a93a95af0a53f0c3fb2e913172b18cdd26df7d1bfbcb35978297661a8bff5274"
-      }
-   ],
-   "lock_time":0,
-   "result":-2430,
-   "size":242,
-   "block_index":0,
-   "time":1597197574,
-   "tx_index":0,
-   "vin_sz":1,
-   "hash":"03c00581760676f17f7a4b070ae06ec077d135b7489adbfaca68de43bccdfc58",
-   "vout_sz":2
-},
-
-
-
-
-
-
-{
-   "ver":2,
-   "inputs":[
-      {
-         "sequence":4294967295,
-         "witness":"",
-         "prev_out":{
-            "spent":true,
-            "spending_outpoints":[
-               {
-                  "tx_index":0,
-                  "n":0
-               }
-            ],
-            "tx_index":0,
-            "type":0,
-            "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-            "value":38950,
-            "n":0,
-            "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-         },
-         "script":"47304402205ce6ddcff7e4702da487e07d72b4535e5ca99f897267bef3a26ec9756fc200ad0220128a939b7caf9de7e8b733c9c9ab1125880e4a9d38fde141aa9767779afc48cf0121027e706cd1c919431b693a0247e4a239e632659a8723a621a91ec610c64f4173ac"
-      }
-   ],
-   "weight":968,
-   "block_height":643303,
-   "relayed_by":"0.0.0.0",
-   "out":[
-      {
-         "spent":true,
-         "spending_outpoints":[
-            {
-               "tx_index":0,
-               "n":0
-            }
-         ],
-         "tx_index":0,
-         "type":0,
-         "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-         "value":36520,
-         "n":0,
-         "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-      },
-      {
-         "spent":false,
-         "tx_index":0,
-         "type":0,
-         "value":0,
-         "n":1,
-         "script":"6a28466100000003d61f38a1a743bb6d5608f2189e3742486f495edd8d440f8085f7b382934d9b838a76"
-      }
-   ],
-   "lock_time":0,
-   "result":-2430,
-   "size":242,
-   "block_index":0,
-   "time":1597197419,
-   "tx_index":0,
-   "vin_sz":1,
-   "hash":"c09a3b670db8172f5746a96ac3bf7c61c29071312d0feb19fbef058fd67749f0",
-   "vout_sz":2
-},
-
-
-
-
-
-
-{
-   "ver":2,
-   "inputs":[
-      {
-         "sequence":4294967295,
-         "witness":"",
-         "prev_out":{
-            "spent":true,
-            "spending_outpoints":[
-               {
-                  "tx_index":0,
-                  "n":0
-               }
-            ],
-            "tx_index":0,
-            "type":0,
-            "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-            "value":24967,
-            "n":0,
-            "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-         },
-         "script":"473044022062db0aa83af76dcf333ae85dc444a574b9a7824a3045a1ad480ec07fa031e9f40220416fd45b0fdc4bd24573bac79ba76ce26f6733374ff64bf631a832e3611ee37e0121027e706cd1c919431b693a0247e4a239e632659a8723a621a91ec610c64f4173ac"
-      }
-   ],
-   "weight":968,
-   "block_height":643303,
-   "relayed_by":"0.0.0.0",
-   "out":[
-      {
-         "spent":true,
-         "spending_outpoints":[
-            {
-               "tx_index":0,
-               "n":1
-            }
-         ],
-         "tx_index":0,
-         "type":0,
-         "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-         "value":22537,
-         "n":0,
-         "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-      },
-      {
-         "spent":false,
-         "tx_index":0,
-         "type":0,
-         "value":0,
-         "n":1,
-         "script":"6a28466100000003d61e3b57ada417f649f6c38b1d62f9eeb9a0cc00d0000807ced66f2ff9adea7f239f"
-      }
-   ],
-   "lock_time":0,
-   "result":-2430,
-   "size":242,
-   "block_index":0,
-   "time":1597197411,
-   "tx_index":0,
-   "vin_sz":1,
-   "hash":"bb43c4f887f5227ae7beea87e21571182e9edcc0a93143c1efa1d7a9d8dad7ae",
-   "vout_sz":2
-},
-
-
-
-
-
-
-{
-   "ver":2,
-   "inputs":[
-      {
-         "sequence":4294967295,
-         "witness":"",
-         "prev_out":{
-            "spent":true,
-            "spending_outpoints":[
-               {
-                  "tx_index":0,
-                  "n":0
-               }
-            ],
-            "tx_index":0,
-            "type":0,
-            "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-            "value":11318,
-            "n":0,
-            "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-         },
-         "script":"47304402201aa18420f4ccca79c4722ca1bdc33493b22d702dccdf3af935f007c04a08b1a602203125d65ec4d78f0d278df74b90d79cb897da8b866da6a59ee89ab5f55c5ef8820121027e706cd1c919431b693a0247e4a239e632659a8723a621a91ec610c64f4173ac"
-      }
-   ],
-   "weight":968,
-   "block_height":643303,
-   "relayed_by":"0.0.0.0",
-   "out":[
-      {
-         "spent":true,
-         "spending_outpoints":[
-            {
-               "tx_index":0,
-               "n":0
-            }
-         ],
-         "tx_index":0,
-         "type":0,
-         "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-         "value":8888,
-         "n":0,
-         "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-      },
-      {
-         "spent":false,
-         "tx_index":0,
-         "type":0,
-         "value":0,
-         "n":1,
-         "script":"6a28466100000003d61dfa61d29efbf1a8a643f74d56c1b89758f0f583cb803b1de272c473b662db7c24"
-      }
-   ],
-   "lock_time":0,
-   "result":-2430,
-   "size":242,
-   "block_index":0,
-   "time":1597197402,
-   "tx_index":0,
-   "vin_sz":1,
-   "hash":"2e61d2335f8548a5e0adca3a9b1f95bd3996b1e3fcb8ce1ef7aa309e41c3e518",
-   "vout_sz":2
-},
-
-
-
-
-
-
-{
-   "ver":2,
-   "inputs":[
-      {
-         "sequence":4294967295,
-         "witness":"",
-         "prev_out":{
-            "spent":true,
-            "spending_outpoints":[
-               {
-                  "tx_index":0,
-                  "n":0
-               }
-            ],
-            "tx_index":0,
-            "type":0,
-            "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-            "value":7360,
-            "n":0,
-            "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-         },
-         "script":"47304402204d108f9f3b7a79cf2499e5fba1b39cea4532b41fa2aeeb3bac464002fc9f6fa102205366488b3d9477000ac0c2e64b51c3aaa1649d4f2fae597ef286e60a9ac83bf70121027e706cd1c919431b693a0247e4a239e632659a8723a621a91ec610c64f4173ac"
-      },
-      {
-         "sequence":4294967295,
-         "witness":"",
-         "prev_out":{
-            "spent":true,
-            "spending_outpoints":[
-               {
-                  "tx_index":0,
-                  "n":0
-               }
-            ],
-            "tx_index":0,
-            "type":0,
-            "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-            "value":7848,
-            "n":0,
-            "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-         },
-         "script":"47304402205b359c9f4f654aa3c2e4515868283702852636c6cdce43f17c0794a14e9010c102207f93597a40fffa11f42fb8d1e39960fa69329f95d2f8c3b689f25f91fc69229f0121027e706cd1c919431b693a0247e4a239e632659a8723a621a91ec610c64f4173ac"
-      }
-   ],
-   "weight":1556,
-   "block_height":643302,
-   "relayed_by":"0.0.0.0",
-   "out":[
-      {
-         "spent":true,
-         "spending_outpoints":[
-            {
-               "tx_index":0,
-               "n":0
-            }
-         ],
-         "tx_index":0,
-         "type":0,
-         "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-         "value":11318,
-         "n":0,
-         "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-      },
-      {
-         "spent":false,
-         "tx_index":0,
-         "type":0,
-         "value":0,
-         "n":1,
-         "script":"6a28466100000003d61cf33b053f086435b83b5afc1e0326caa2575af6291e806ad2e965ef52fbf06d7d"
-      }
-   ],
-   "lock_time":0,
-   "result":-3890,
-   "size":389,
-   "block_index":0,
-   "time":1597196592,
-   "tx_index":0,
-   "vin_sz":2,
-   "hash":"abcb14ed3fa11b49651b61d20df6ec8487ff623007bcacaac5db404a2993ea5e",
-   "vout_sz":2
-},
-
-
-
-
-
-
-{
-   "ver":2,
-   "inputs":[
-      {
-         "sequence":4294967295,
-         "witness":"",
-         "prev_out":{
-            "spent":true,
-            "spending_outpoints":[
-               {
-                  "tx_index":0,
-                  "n":0
-               }
-            ],
-            "tx_index":0,
-            "type":0,
-            "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-            "value":41380,
-            "n":0,
-            "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-         },
-         "script":"47304402201b1d51d399a8b18c4d59fd813049a8446bda2b100f017f94e37f7b719c5a2b21022076450b8c0d7639028a4d883b84f39ce7f74e4761e466399c3a60de1d0aa1629a0121027e706cd1c919431b693a0247e4a239e632659a8723a621a91ec610c64f4173ac"
-      }
-   ],
-   "weight":968,
-   "block_height":643302,
-   "relayed_by":"0.0.0.0",
-   "out":[
-      {
-         "spent":true,
-         "spending_outpoints":[
-            {
-               "tx_index":0,
-               "n":0
-            }
-         ],
-         "tx_index":0,
-         "type":0,
-         "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-         "value":38950,
-         "n":0,
-         "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-      },
-      {
-         "spent":false,
-         "tx_index":0,
-         "type":0,
-         "value":0,
-         "n":1,
-         "script":"6a28466100000003d61bd77c034ea5366fbb359a5d1823adb2a14fe6ec8a5e195217a90c0e48a2656f27"
-      }
-   ],
-   "lock_time":0,
-   "result":-2430,
-   "size":242,
-   "block_index":0,
-   "time":1597196583,
-   "tx_index":0,
-   "vin_sz":1,
-   "hash":"4cc1f6d7c929140ba86fbe4c42fd5d6287aaeaf0c3765a2f8c5dc3580bf54ced",
-   "vout_sz":2
-},
-
-
-
-
-
-
-{
-   "ver":2,
-   "inputs":[
-      {
-         "sequence":4294967295,
-         "witness":"",
-         "prev_out":{
-            "spent":true,
-            "spending_outpoints":[
-               {
-                  "tx_index":0,
-                  "n":0
-               }
-            ],
-            "tx_index":0,
-            "type":0,
-            "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-            "value":27397,
-            "n":0,
-            "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-         },
-         "script":"47304402205db3f395f4c53e04af4f94c3bbfdfba4dc7fd62b77edbd86caf276060ecca83302202582061f3ad06e04a306d3f3b567b03feaf248a41383fb930d6187701f2a8f950121027e706cd1c919431b693a0247e4a239e632659a8723a621a91ec610c64f4173ac"
-      }
-   ],
-   "weight":968,
-   "block_height":643302,
-   "relayed_by":"0.0.0.0",
-   "out":[
-      {
-         "spent":true,
-         "spending_outpoints":[
-            {
-               "tx_index":0,
-               "n":0
-            }
-         ],
-         "tx_index":0,
-         "type":0,
-         "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-         "value":24967,
-         "n":0,
-         "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-      },
-      {
-         "spent":false,
-         "tx_index":0,
-         "type":0,
-         "value":0,
-         "n":1,
-         "script":"6a28466100000003d61a33fb8b4f8385d977cd47d3316d4eb5209537b7244c893e93aef01060051a4651"
-      }
-   ],
-   "lock_time":0,
-   "result":-2430,
-   "size":242,
-   "block_index":0,
-   "time":1597196574,
-   "tx_index":0,
-   "vin_sz":1,
-   "hash":"6ae048371264e5cae7ab32ea51b9e9792f30608923619a3f03e9b466f053d9a2",
-   "vout_sz":2
-},
-
-
-
-
-
-
-{
-   "ver":2,
-   "inputs":[
-      {
-         "sequence":4294967295,
-         "witness":"",
-         "prev_out":{
-            "spent":true,
-            "spending_outpoints":[
-               {
-                  "tx_index":0,
-                  "n":0
-               }
-            ],
-            "tx_index":0,
-            "type":0,
-            "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-            "value":10278,
-            "n":0,
-            "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-         },
-         "script":"4730440220305f0e01d6c39c45777f094a4b30bc0cf2c0c266fa0ed736ce6f45a393e8858102205dd8810463bb981191bce1bf56a3d2ab9a491e6b2294841e09940f2e9fb7aaad0121027e706cd1c919431b693a0247e4a239e632659a8723a621a91ec610c64f4173ac"
-      }
-   ],
-   "weight":968,
-   "block_height":643301,
-   "relayed_by":"0.0.0.0",
-   "out":[
-      {
-         "spent":true,
-         "spending_outpoints":[
-            {
-               "tx_index":0,
-               "n":1
-            }
-         ],
-         "tx_index":0,
-         "type":0,
-         "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-         "value":7848,
-         "n":0,
-         "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-      },
-      {
-         "spent":false,
-         "tx_index":0,
-         "type":0,
-         "value":0,
-         "n":1,
-         "script":"6a28466100000003d6188960f0762395666cd8e8c29f337418af579ce28cce3334b581202725550cdb2d"
-      }
-   ],
-   "lock_time":0,
-   "result":-2430,
-   "size":242,
-   "block_index":0,
-   "time":1597196356,
-   "tx_index":0,
-   "vin_sz":1,
-   "hash":"94a62bd3c9c69b3e765b614c015cec28bed00345742a661bf914eb56cc1f2bf1",
-   "vout_sz":2
-},
-
-
-
-
-
-
-{
-   "ver":2,
-   "inputs":[
-      {
-         "sequence":4294967295,
-         "witness":"",
-         "prev_out":{
-            "spent":true,
-            "spending_outpoints":[
-               {
-                  "tx_index":0,
-                  "n":0
-               }
-            ],
-            "tx_index":0,
-            "type":0,
-            "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-            "value":9790,
-            "n":0,
-            "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-         },
-         "script":"4730440220509a26ac3d4873bc7a7880fd3cdd5b793382d4841c96dab27ee4f3cf96d6209d02207dad347bde617288ceff8f5c52c092586b83e56e0b105593d5d38c860fa53f2a0121027e706cd1c919431b693a0247e4a239e632659a8723a621a91ec610c64f4173ac"
-      }
-   ],
-   "weight":968,
-   "block_height":643301,
-   "relayed_by":"0.0.0.0",
-   "out":[
-      {
-         "spent":true,
-         "spending_outpoints":[
-            {
-               "tx_index":0,
-               "n":0
-            }
-         ],
-         "tx_index":0,
-         "type":0,
-         "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-         "value":7360,
-         "n":0,
-         "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-      },
-      {
-         "spent":false,
-         "tx_index":0,
-         "type":0,
-         "value":0,
-         "n":1,
-         "script":"6a28466100000003d6176e7be49931b14200f32c234465819663657af83d1e2b58af792d3eba965264b9"
-      }
-   ],
-   "lock_time":0,
-   "result":-2430,
-   "size":242,
-   "block_index":0,
-   "time":1597196346,
-   "tx_index":0,
-   "vin_sz":1,
-   "hash":"44491368340873ac0a9ced12e68ff1592d06847e3927583bb8d6291073e2b0b2",
-   "vout_sz":2
-},
-
-
-
-
-
-
-{
-   "ver":2,
-   "inputs":[
-      {
-         "sequence":4294967295,
-         "witness":"",
-         "prev_out":{
-            "spent":true,
-            "spending_outpoints":[
-               {
-                  "tx_index":0,
-                  "n":0
-               }
-            ],
-            "tx_index":0,
-            "type":0,
-            "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-            "value":43810,
-            "n":0,
-            "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-         },
-         "script":"47304402204857c55b2862f1ec2f8c405bf35809782c53ba1d5588f6c053a68b233af8f3fa02207ee1838f3524812d9a3fd9fda50bd964121bbfcd9a34b92ecd8b965773125c960121027e706cd1c919431b693a0247e4a239e632659a8723a621a91ec610c64f4173ac"
-      }
-   ],
-   "weight":968,
-   "block_height":643301,
-   "relayed_by":"0.0.0.0",
-   "out":[
-      {
-         "spent":true,
-         "spending_outpoints":[
-            {
-               "tx_index":0,
-               "n":0
-            }
-         ],
-         "tx_index":0,
-         "type":0,
-         "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-         "value":41380,
-         "n":0,
-         "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-      },
-      {
-         "spent":false,
-         "tx_index":0,
-         "type":0,
-         "value":0,
-         "n":1,
-         "script":"6a28466100000003d61978dbc04c6d4994af570f406725fe5df1422d9c2eeb64f0040da5f9ed3d50a908"
-      }
-   ],
-   "lock_time":0,
-   "result":-2430,
-   "size":242,
-   "block_index":0,
-   "time":1597196361,
-   "tx_index":0,
-   "vin_sz":1,
-   "hash":"f0c58d5ef4a22ee69dfb8bda288b530a92b601451a322fc1eeda49ecb751ee87",
-   "vout_sz":2
-},
-
-
-
-
-
-
-{
-   "ver":2,
-   "inputs":[
-      {
-         "sequence":4294967295,
-         "witness":"",
-         "prev_out":{
-            "spent":true,
-            "spending_outpoints":[
-               {
-                  "tx_index":0,
-                  "n":0
-               }
-            ],
-            "tx_index":0,
-            "type":0,
-            "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-            "value":29827,
-            "n":0,
-            "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-         },
-         "script":"473044022005ff3397b9c173ca2b262589d0c38bd3af649f0edaefa37cc364be2f9089c85a0220069a4053480356c646a27c160bad7cb5ed0fb06cd590917ce24d79bb49698d800121027e706cd1c919431b693a0247e4a239e632659a8723a621a91ec610c64f4173ac"
-      }
-   ],
-   "weight":968,
-   "block_height":643301,
-   "relayed_by":"0.0.0.0",
-   "out":[
-      {
-         "spent":true,
-         "spending_outpoints":[
-            {
-               "tx_index":0,
-               "n":0
-            }
-         ],
-         "tx_index":0,
-         "type":0,
-         "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-         "value":27397,
-         "n":0,
-         "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-      },
-      {
-         "spent":false,
-         "tx_index":0,
-         "type":0,
-         "value":0,
-         "n":1,
-         "script":"6a28466100000003eea8da1ada384e2dfd7267dd4e400f39b65584c4e9593eb83611f6019e2af77a5f3d"
-      }
-   ],
-   "lock_time":0,
-   "result":-2430,
-   "size":242,
-   "block_index":0,
-   "time":1597196315,
-   "tx_index":0,
-   "vin_sz":1,
-   "hash":"8141828bdc750008e612a9a71bcd6ab41a003c82af2dda50329cbc6e028dbb3f",
-   "vout_sz":2
-},
-
-
-
-
-
-
-{
-   "ver":2,
-   "inputs":[
-      {
-         "sequence":4294967295,
-         "witness":"",
-         "prev_out":{
-            "spent":true,
-            "spending_outpoints":[
-               {
-                  "tx_index":0,
-                  "n":0
-               }
-            ],
-            "tx_index":0,
-            "type":0,
-            "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-            "value":46240,
-            "n":0,
-            "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-         },
-         "script":"4730440220126313d6126b65d9d856d0eedd7a73f432848438ad24b3bbe4f19aef8fe26e67022038ade115e15b336428de68829d4a16938e4d8c96b39b0471fae453e303eba9b70121027e706cd1c919431b693a0247e4a239e632659a8723a621a91ec610c64f4173ac"
-      }
-   ],
-   "weight":968,
-   "block_height":643299,
-   "relayed_by":"0.0.0.0",
-   "out":[
-      {
-         "spent":true,
-         "spending_outpoints":[
-            {
-               "tx_index":0,
-               "n":0
-            }
-         ],
-         "tx_index":0,
-         "type":0,
-         "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-         "value":43810,
-         "n":0,
-         "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-      },
-      {
-         "spent":false,
-         "tx_index":0,
-         "type":0,
-         "value":0,
-         "n":1,
-         "script":"6a28466100000003eea76d499d3066b5bc34585b64bb4422d873753a5ec39eef9c239808dd97a11487a4"
-      }
-   ],
-   "lock_time":0,
-   "result":-2430,
-   "size":242,
-   "block_index":0,
-   "time":1597195739,
-   "tx_index":0,
-   "vin_sz":1,
-   "hash":"63198b006808fb482cb1cb34672a609737f72b482a0491820361653f366f68a2",
-   "vout_sz":2
-},
-
-
-
-
-
-
-{
-   "ver":2,
-   "inputs":[
-      {
-         "sequence":4294967295,
-         "witness":"",
-         "prev_out":{
-            "spent":true,
-            "spending_outpoints":[
-               {
-                  "tx_index":0,
-                  "n":0
-               }
-            ],
-            "tx_index":0,
-            "type":0,
-            "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-            "value":12708,
-            "n":0,
-            "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-         },
-         "script":"4730440220033f2124f7e3cde4e739f70d2b968397717faca594364515c8fbd511db06e6b602200f974d880c03b96cc956464d02f4ac2adbb6e7b344afefa6b5db5b0f2bbfff580121027e706cd1c919431b693a0247e4a239e632659a8723a621a91ec610c64f4173ac"
-      }
-   ],
-   "weight":968,
-   "block_height":643299,
-   "relayed_by":"0.0.0.0",
-   "out":[
-      {
-         "spent":true,
-         "spending_outpoints":[
-            {
-               "tx_index":0,
-               "n":0
-            }
-         ],
-         "tx_index":0,
-         "type":0,
-         "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-         "value":10278,
-         "n":0,
-         "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-      },
-      {
-         "spent":false,
-         "tx_index":0,
-         "type":0,
-         "value":0,
-         "n":1,
-         "script":"6a28466100000003d613b59708e6e1c7671cc2cb0bc9c3ec5e4d8860d4b5bef0b43d02fd4a5d356963f5"
-      }
-   ],
-   "lock_time":0,
-   "result":-2430,
-   "size":242,
-   "block_index":0,
-   "time":1597195762,
-   "tx_index":0,
-   "vin_sz":1,
-   "hash":"61af328a6124418a47e9aba9aaab9306d958f89a43758cbdd9bc5524a115aa5a",
-   "vout_sz":2
-},
-
-
-
-
-
-
-{
-   "ver":2,
-   "inputs":[
-      {
-         "sequence":4294967295,
-         "witness":"",
-         "prev_out":{
-            "spent":true,
-            "spending_outpoints":[
-               {
-                  "tx_index":0,
-                  "n":0
-               }
-            ],
-            "tx_index":0,
-            "type":0,
-            "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-            "value":12220,
-            "n":0,
-            "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-         },
-         "script":"473044022070c5c60ce00e9106e86d62fd020ba4b2063f061b1cb2b78d7d73ed3371dc82dd022011128d28680f09d1e07f3e29495d23177be1b5450b0ec1634fab00697c392e4f0121027e706cd1c919431b693a0247e4a239e632659a8723a621a91ec610c64f4173ac"
-      }
-   ],
-   "weight":968,
-   "block_height":643299,
-   "relayed_by":"0.0.0.0",
-   "out":[
-      {
-         "spent":true,
-         "spending_outpoints":[
-            {
-               "tx_index":0,
-               "n":0
-            }
-         ],
-         "tx_index":0,
-         "type":0,
-         "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-         "value":9790,
-         "n":0,
-         "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-      },
-      {
-         "spent":false,
-         "tx_index":0,
-         "type":0,
-         "value":0,
-         "n":1,
-         "script":"6a28466100000003d61677ab05cc1c08ff64d20744defb6a329ee270806e608cf54d2756e2b7e0bd8156"
-      }
-   ],
-   "lock_time":0,
-   "result":-2430,
-   "size":242,
-   "block_index":0,
-   "time":1597195774,
-   "tx_index":0,
-   "vin_sz":1,
-   "hash":"88277d43d661ec67c8ab17b744f9ad76a8c7d0934f82ec7d4a49b2648ec99311",
-   "vout_sz":2
-},
-
-
-
-
-
-
-{
-   "ver":2,
-   "inputs":[
-      {
-         "sequence":4294967295,
-         "witness":"",
-         "prev_out":{
-            "spent":true,
-            "spending_outpoints":[
-               {
-                  "tx_index":0,
-                  "n":0
-               }
-            ],
-            "tx_index":0,
-            "type":0,
-            "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-            "value":32257,
-            "n":0,
-            "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-         },
-         "script":"4730440220415087b2c11a68575d4da7fed716730982100630437af457ceb4a3ccab17295402205380640bb5d818c19a67c556c84fa124a8187192dd14adc4fb1cabf5d4ebcef30121027e706cd1c919431b693a0247e4a239e632659a8723a621a91ec610c64f4173ac"
-      }
-   ],
-   "weight":968,
-   "block_height":643299,
-   "relayed_by":"0.0.0.0",
-   "out":[
-      {
-         "spent":true,
-         "spending_outpoints":[
-            {
-               "tx_index":0,
-               "n":0
-            }
-         ],
-         "tx_index":0,
-         "type":0,
-         "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-         "value":29827,
-         "n":0,
-         "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-      },
-      {
-         "spent":false,
-         "tx_index":0,
-         "type":0,
-         "value":0,
-         "n":1,
-         "script":"6a28466100000003d61511ab1961251b5793f04d662d4eaa12b2794829ee00744239fcc0f56ff7c450a6"
-      }
-   ],
-   "lock_time":0,
-   "result":-2430,
-   "size":242,
-   "block_index":0,
-   "time":1597195769,
-   "tx_index":0,
-   "vin_sz":1,
-   "hash":"62e7c61a9b2f3c4b199e3ff9076f092e175de7a48fd25c43f9343cc8ca7b0c04",
-   "vout_sz":2
-},
-
-
-
-
-
-
-{
-   "ver":2,
-   "inputs":[
-      {
-         "sequence":4294967295,
-         "witness":"",
-         "prev_out":{
-            "spent":true,
-            "spending_outpoints":[
-               {
-                  "tx_index":0,
-                  "n":0
-               }
-            ],
-            "tx_index":0,
-            "type":0,
-            "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-            "value":14650,
-            "n":0,
-            "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-         },
-         "script":"4730440220430b19b03d3b96b7d2eb52aca7a2944bf23fb3d66a39912c6979e064005fd8e1022037f15d488108e327fa8e00105996cb37fd07433b74b64a1dfcdc72ebd830faa30121027e706cd1c919431b693a0247e4a239e632659a8723a621a91ec610c64f4173ac"
-      }
-   ],
-   "weight":968,
-   "block_height":643298,
-   "relayed_by":"0.0.0.0",
-   "out":[
-      {
-         "spent":true,
-         "spending_outpoints":[
-            {
-               "tx_index":0,
-               "n":0
-            }
-         ],
-         "tx_index":0,
-         "type":0,
-         "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-         "value":12220,
-         "n":0,
-         "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-      },
-      {
-         "spent":false,
-         "tx_index":0,
-         "type":0,
-         "value":0,
-         "n":1,
-         "script":"6a28466100000003d612d97b539a6261e0f13f913b43fdb74d14c9cad308aa68fb64d528339078f30153"
-      }
-   ],
-   "lock_time":0,
-   "result":-2430,
-   "size":242,
-   "block_index":0,
-   "time":1597193128,
-   "tx_index":0,
-   "vin_sz":1,
-   "hash":"ff26a0411458112019c2b31326b8700a39a62e3fe8cb55c3c434e88e4e30c0f7",
-   "vout_sz":2
-},
-
-
-
-
-
-
-{
-   "ver":2,
-   "inputs":[
-      {
-         "sequence":4294967295,
-         "witness":"",
-         "prev_out":{
-            "spent":true,
-            "spending_outpoints":[
-               {
-                  "tx_index":0,
-                  "n":0
-               }
-            ],
-            "tx_index":0,
-            "type":0,
-            "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-            "value":34687,
-            "n":0,
-            "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-         },
-         "script":"473044022026ef951a01f93ec6b036a0bfb9d174b846e910566515118fdad19753e8b2d846022064341e1ad5b3454614b46505b8a15d1c4a8e900d05717533019cf125b567a8010121027e706cd1c919431b693a0247e4a239e632659a8723a621a91ec610c64f4173ac"
-      }
-   ],
-   "weight":968,
-   "block_height":643298,
-   "relayed_by":"0.0.0.0",
-   "out":[
-      {
-         "spent":true,
-         "spending_outpoints":[
-            {
-               "tx_index":0,
-               "n":0
-            }
-         ],
-         "tx_index":0,
-         "type":0,
-         "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-         "value":32257,
-         "n":0,
-         "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-      },
-      {
-         "spent":false,
-         "tx_index":0,
-         "type":0,
-         "value":0,
-         "n":1,
-         "script":"6a28466100000003d6111c5278a9ca35f5560c036342c93aa85e7b8a2baac19415a8c9830b1bbb5ba739"
-      }
-   ],
-   "lock_time":0,
-   "result":-2430,
-   "size":242,
-   "block_index":0,
-   "time":1597193121,
-   "tx_index":0,
-   "vin_sz":1,
-   "hash":"a5abe439b297718235ca5fe7e2540ebcc872d67631a9d6e37b96c324a267f1ce",
-   "vout_sz":2
-},
-
-
-
-
-
-
-{
-   "ver":2,
-   "inputs":[
-      {
-         "sequence":4294967295,
-         "witness":"",
-         "prev_out":{
-            "spent":true,
-            "spending_outpoints":[
-               {
-                  "tx_index":0,
-                  "n":0
-               }
-            ],
-            "tx_index":0,
-            "type":0,
-            "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-            "value":15138,
-            "n":0,
-            "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-         },
-         "script":"47304402205de5cffcf5c884a9d2623d44c2031922e2a880ff8762698e6911cace22128cc702207bc5d80718b853667518924dd52798e2307fc26fccd527f4de711ec0b718dd600121027e706cd1c919431b693a0247e4a239e632659a8723a621a91ec610c64f4173ac"
-      }
-   ],
-   "weight":968,
-   "block_height":643298,
-   "relayed_by":"0.0.0.0",
-   "out":[
-      {
-         "spent":true,
-         "spending_outpoints":[
-            {
-               "tx_index":0,
-               "n":0
-            }
-         ],
-         "tx_index":0,
-         "type":0,
-         "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-         "value":12708,
-         "n":0,
-         "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-      },
-      {
-         "spent":false,
-         "tx_index":0,
-         "type":0,
-         "value":0,
-         "n":1,
-         "script":"6a28466100000003eea3c1198b691f5a8a0318851d025a497fa4b04e0797b56955b81136d60a562cf415"
-      }
-   ],
-   "lock_time":0,
-   "result":-2430,
-   "size":242,
-   "block_index":0,
-   "time":1597193106,
-   "tx_index":0,
-   "vin_sz":1,
-   "hash":"54439ab2a608801d86291723f3f11ae08b089af74e5833845b2400017df27da0",
-   "vout_sz":2
-},
-
-
-
-
-
-
-{
-   "ver":2,
-   "inputs":[
-      {
-         "sequence":4294967295,
-         "witness":"",
-         "prev_out":{
-            "spent":true,
-            "spending_outpoints":[
-               {
-                  "tx_index":0,
-                  "n":0
-               }
-            ],
-            "tx_index":0,
-            "type":0,
-            "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-            "value":48670,
-            "n":0,
-            "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-         },
-         "script":"4730440220493eac921150a99f8fdd3aeec3e7678a24b76b7111631d6a053103c2cd5a16f0022018411a477e11962a45f505a752776ca06ec7df00a26952f35ef4dac6734f92c90121027e706cd1c919431b693a0247e4a239e632659a8723a621a91ec610c64f4173ac"
-      }
-   ],
-   "weight":968,
-   "block_height":643298,
-   "relayed_by":"0.0.0.0",
-   "out":[
-      {
-         "spent":true,
-         "spending_outpoints":[
-            {
-               "tx_index":0,
-               "n":0
-            }
-         ],
-         "tx_index":0,
-         "type":0,
-         "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-         "value":46240,
-         "n":0,
-         "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-      },
-      {
-         "spent":false,
-         "tx_index":0,
-         "type":0,
-         "value":0,
-         "n":1,
-         "script":"6a28466100000003d6109901697e706b6dea9c2a0c01238a51fe0018efa731bc0622af2c67b870139d26"
-      }
-   ],
-   "lock_time":0,
-   "result":-2430,
-   "size":242,
-   "block_index":0,
-   "time":1597193120,
-   "tx_index":0,
-   "vin_sz":1,
-   "hash":"f339c36a6b53676a7c44c249ba5db7eb1636140c6481bb6019fc883badc24195",
-   "vout_sz":2
-},
-
-
-
-
-
-
-{
-   "ver":2,
-   "inputs":[
-      {
-         "sequence":4294967295,
-         "witness":"",
-         "prev_out":{
-            "spent":true,
-            "spending_outpoints":[
-               {
-                  "tx_index":0,
-                  "n":0
-               }
-            ],
-            "tx_index":0,
-            "type":0,
-            "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-            "value":17080,
-            "n":0,
-            "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-         },
-         "script":"4730440220201b442e4dada8b6db0fba29c6f2b72512e2998b28eab041e456793c19da66b302204fd81d5fd160aa10f7c5854b974f8b33aea8cca1911e9ab39ebfe31daa2d42fd0121027e706cd1c919431b693a0247e4a239e632659a8723a621a91ec610c64f4173ac"
-      }
-   ],
-   "weight":968,
-   "block_height":643295,
-   "relayed_by":"0.0.0.0",
-   "out":[
-      {
-         "spent":true,
-         "spending_outpoints":[
-            {
-               "tx_index":0,
-               "n":0
-            }
-         ],
-         "tx_index":0,
-         "type":0,
-         "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-         "value":14650,
-         "n":0,
-         "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-      },
-      {
-         "spent":false,
-         "tx_index":0,
-         "type":0,
-         "value":0,
-         "n":1,
-         "script":"6a28466100000003d60fb798b1a8ed50cfb34e19a1f4bc8f8a2f7e051e9622ca29f5e1d27c7763bd64d3"
-      }
-   ],
-   "lock_time":0,
-   "result":-2430,
-   "size":242,
-   "block_index":0,
-   "time":1597191930,
-   "tx_index":0,
-   "vin_sz":1,
-   "hash":"b56bade0faf818b2c51ae52b31dec5a363eb7c09a60423acea77516cae2ef9fc",
-   "vout_sz":2
-},
-
-
-
-
-
-
-{
-   "ver":2,
-   "inputs":[
-      {
-         "sequence":4294967295,
-         "witness":"",
-         "prev_out":{
-            "spent":true,
-            "spending_outpoints":[
-               {
-                  "tx_index":0,
-                  "n":0
-               }
-            ],
-            "tx_index":0,
-            "type":0,
-            "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-            "value":37117,
-            "n":0,
-            "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-         },
-         "script":"473044022020f59e9d6dc48d7e8315f13508f5b07358d088175d3662a8c60bf0dd885e33a4022006810dd50dc5632350b34b64a5edb0b1911a069b328d8d35d7941a37e87ee2c20121027e706cd1c919431b693a0247e4a239e632659a8723a621a91ec610c64f4173ac"
-      }
-   ],
-   "weight":968,
-   "block_height":643295,
-   "relayed_by":"0.0.0.0",
-   "out":[
-      {
-         "spent":true,
-         "spending_outpoints":[
-            {
-               "tx_index":0,
-               "n":0
-            }
-         ],
-         "tx_index":0,
-         "type":0,
-         "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-         "value":34687,
-         "n":0,
-         "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-      },
-      {
-         "spent":false,
-         "tx_index":0,
-         "type":0,
-         "value":0,
-         "n":1,
-         "script":"6a28466100000003d60eed4554724a282d32bcd987faf1b340033137fe553e45112c17f7e2158906e0b7"
-      }
-   ],
-   "lock_time":0,
-   "result":-2430,
-   "size":242,
-   "block_index":0,
-   "time":1597191924,
-   "tx_index":0,
-   "vin_sz":1,
-   "hash":"e437ba5ed154530fddbfa67f4c83e2558531e03544122f41e4955ec20151c4bc",
-   "vout_sz":2
-},
-
-
-
-
-
-
-{
-   "ver":2,
-   "inputs":[
-      {
-         "sequence":4294967295,
-         "witness":"",
-         "prev_out":{
-            "spent":true,
-            "spending_outpoints":[
-               {
-                  "tx_index":0,
-                  "n":0
-               }
-            ],
-            "tx_index":0,
-            "type":0,
-            "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-            "value":51100,
-            "n":0,
-            "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-         },
-         "script":"473044022022ddf5fe1940c2320082f2557b7c627e64aa5724fa78ba5a21783c92d0216fb902204b9817756bf3c6d38259e7065f07312bf13e28a82b6532214ba0ca43ae736e8a0121027e706cd1c919431b693a0247e4a239e632659a8723a621a91ec610c64f4173ac"
-      }
-   ],
-   "weight":968,
-   "block_height":643295,
-   "relayed_by":"0.0.0.0",
-   "out":[
-      {
-         "spent":true,
-         "spending_outpoints":[
-            {
-               "tx_index":0,
-               "n":0
-            }
-         ],
-         "tx_index":0,
-         "type":0,
-         "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-         "value":48670,
-         "n":0,
-         "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-      },
-      {
-         "spent":false,
-         "tx_index":0,
-         "type":0,
-         "value":0,
-         "n":1,
-         "script":"6a28466100000003eea1ed29e54ee9d3f65ba7b96c2d692a88ea4f2ab3105c4fce27e78cbe1d9b08dd32"
-      }
-   ],
-   "lock_time":0,
-   "result":-2430,
-   "size":242,
-   "block_index":0,
-   "time":1597191909,
-   "tx_index":0,
-   "vin_sz":1,
-   "hash":"7a52fa730abea881a4a17822ca7d04b19f54ce79252eae1ede834cd32c208db7",
-   "vout_sz":2
-},
-
-
-
-
-
-
-{
-   "ver":2,
-   "inputs":[
-      {
-         "sequence":4294967295,
-         "witness":"",
-         "prev_out":{
-            "spent":true,
-            "spending_outpoints":[
-               {
-                  "tx_index":0,
-                  "n":0
-               }
-            ],
-            "tx_index":0,
-            "type":0,
-            "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-            "value":17568,
-            "n":0,
-            "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-         },
-         "script":"47304402200414fdc9449059f387adf38dbd3667c81c4fa9f1b3551cf32d598551232ef2f9022056765ceeea92bd97da4c733f47ed2b3da44001d31d0c6904b2c2857283ca09a80121027e706cd1c919431b693a0247e4a239e632659a8723a621a91ec610c64f4173ac"
-      }
-   ],
-   "weight":968,
-   "block_height":643295,
-   "relayed_by":"0.0.0.0",
-   "out":[
-      {
-         "spent":true,
-         "spending_outpoints":[
-            {
-               "tx_index":0,
-               "n":0
-            }
-         ],
-         "tx_index":0,
-         "type":0,
-         "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-         "value":15138,
-         "n":0,
-         "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-      },
-      {
-         "spent":false,
-         "tx_index":0,
-         "type":0,
-         "value":0,
-         "n":1,
-         "script":"6a28466100000003d60dc79d67fb32adc7aeb47726cece08212a2faccc2bf8d95b3adbd95880d7d55a1e"
-      }
-   ],
-   "lock_time":0,
-   "result":-2430,
-   "size":242,
-   "block_index":0,
-   "time":1597191920,
-   "tx_index":0,
-   "vin_sz":1,
-   "hash":"e7efce0a9ac11f0819fb78fe039cbb05b757bf2aac941ebd6e566d2595f71b16",
-   "vout_sz":2
-},
-
-
-
-
-
-
-{
-   "ver":2,
-   "inputs":[
-      {
-         "sequence":4294967295,
-         "witness":"",
-         "prev_out":{
-            "spent":true,
-            "spending_outpoints":[
-               {
-                  "tx_index":0,
-                  "n":0
-               }
-            ],
-            "tx_index":0,
-            "type":0,
-            "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-            "value":19510,
-            "n":0,
-            "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-         },
-         "script":"4730440220307fe67c2e8f4279de95f7169a773276d2d04637eb302e6a1ca249d2354af87a022071a46f0e53d0f2655f3cecd4a350db03cecf9ec6c038ccb09e5e0aa777c038650121027e706cd1c919431b693a0247e4a239e632659a8723a621a91ec610c64f4173ac"
-      }
-   ],
-   "weight":968,
-   "block_height":643294,
-   "relayed_by":"0.0.0.0",
-   "out":[
-      {
-         "spent":true,
-         "spending_outpoints":[
-            {
-               "tx_index":0,
-               "n":0
-            }
-         ],
-         "tx_index":0,
-         "type":0,
-         "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-         "value":17080,
-         "n":0,
-         "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-      },
-      {
-         "spent":false,
-         "tx_index":0,
-         "type":0,
-         "value":0,
-         "n":1,
-         "script":"6a28466100000003d60a422c9b5933b2a6bc2eec9416d3c919193285e56de9323ccf4eb6528c4dfc7037"
-      }
-   ],
-   "lock_time":0,
-   "result":-2430,
-   "size":242,
-   "block_index":0,
-   "time":1597191064,
-   "tx_index":0,
-   "vin_sz":1,
-   "hash":"120b59011a181e4cb34dd5932efca7bde3be1bcb095010aa199c1835be7c35d7",
-   "vout_sz":2
-},
-
-
-
-
-
-
-{
-   "ver":2,
-   "inputs":[
-      {
-         "sequence":4294967295,
-         "witness":"",
-         "prev_out":{
-            "spent":true,
-            "spending_outpoints":[
-               {
-                  "tx_index":0,
-                  "n":0
-               }
-            ],
-            "tx_index":0,
-            "type":0,
-            "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-            "value":39547,
-            "n":0,
-            "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-         },
-         "script":"47304402200593f93852dc2bfedb745763c0f37f4d1e6bac2af64c32a9973bcb2621c706c2022027da53f9b1b3358114daa91e7ffe59967f7ee043282de490f2dea818aae367d30121027e706cd1c919431b693a0247e4a239e632659a8723a621a91ec610c64f4173ac"
-      }
-   ],
-   "weight":968,
-   "block_height":643294,
-   "relayed_by":"0.0.0.0",
-   "out":[
-      {
-         "spent":true,
-         "spending_outpoints":[
-            {
-               "tx_index":0,
-               "n":0
-            }
-         ],
-         "tx_index":0,
-         "type":0,
-         "addr":"1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
-         "value":37117,
-         "n":0,
-         "script":"76a914c5b7fd920dce5f61934e792c7e6fcc829aff533d88ac"
-      },
-      {
-         "spent":false,
-         "tx_index":0,
-         "type":0,
-         "value":0,
-         "n":1,
-         "script":"6a28466100000003d60c6a4d90baf07a88e210961a5b22909197b15c4df0e7b89413941d1c212da1dbe3"
-      }
-   ],
-   "lock_time":0,
-   "result":-2430,
-   "size":242,
-   "block_index":0,
-   "time":1597191076,
-   "tx_index":0,
-   "vin_sz":1,
-   "hash":"741d1b765a502f230f91eaada8f3a48db894b9958877e2007ca06b624407f1aa",
-   "vout_sz":2
-}
-    ]
-}`)
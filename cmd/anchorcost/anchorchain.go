@@ -0,0 +1,113 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/FactomProject/factom"
+	"github.com/WhoSoup/factom-anchor-cost/anchorcost"
+)
+
+// anchorSource returns the anchors of a directory block height
+type anchorSource func(height int64) (*factom.Anchors, error)
+
+// apiAnchors uses the anchors api of factomd
+func apiAnchors(height int64) (*factom.Anchors, error) {
+	var anchor *factom.Anchors
+	err := anchorcost.DefaultRetry.Do(func() error {
+		var err error
+		anchor, err = factom.GetAnchorsByHeight(height)
+		return factomErr(err)
+	})
+	return anchor, err
+}
+
+var zeroHash = strings.Repeat("0", 64)
+
+// factomRetry calls factomd with the default retry policy
+func factomRetry(call func() error) error {
+	return anchorcost.DefaultRetry.Do(func() error {
+		return factomErr(call())
+	})
+}
+
+// chainAnchors walks the anchor chain back from its head to the entry block
+// written before start and indexes the bitcoin anchors by height. It only
+// needs the entry apis every factomd has. Heights that were anchored more
+// than once keep the earliest record, like factomd does.
+func chainAnchors(start int64) (anchorSource, error) {
+	var head string
+	if err := factomRetry(func() (err error) {
+		head, _, err = factom.GetChainHead(anchorcost.AnchorChainID)
+		return
+	}); err != nil {
+		return nil, fmt.Errorf("anchor chain head: %w", err)
+	}
+
+	index := make(map[int64]*factom.Anchors)
+	var last int64 = -1
+	for keymr := head; keymr != zeroHash && keymr != ""; {
+		if interrupted() {
+			return nil, errInterrupted
+		}
+
+		var eb *factom.EBlock
+		if err := factomRetry(func() (err error) {
+			eb, err = factom.GetEBlock(keymr)
+			return
+		}); err != nil {
+			return nil, fmt.Errorf("entry block %s: %w", keymr, err)
+		}
+
+		for i := len(eb.EntryList) - 1; i >= 0; i-- {
+			hash := eb.EntryList[i].EntryHash
+			var e *factom.Entry
+			if err := factomRetry(func() (err error) {
+				e, err = factom.GetEntry(hash)
+				return
+			}); err != nil {
+				return nil, fmt.Errorf("entry %s: %w", hash, err)
+			}
+
+			rec, err := anchorcost.ParseAnchorRecord(e.Content)
+			if err != nil {
+				// the first entry of the chain is not a record
+				fmt.Println("skipping entry", hash, err)
+				continue
+			}
+			if rec.Bitcoin == nil {
+				continue
+			}
+
+			height := int64(rec.DBHeight)
+			index[height] = &factom.Anchors{
+				Height: rec.DBHeight,
+				KeyMR:  rec.KeyMR,
+				Bitcoin: &factom.AnchorBitcoin{
+					TransactionHash: rec.Bitcoin.TXID,
+					BlockHash:       rec.Bitcoin.BlockHash,
+				},
+			}
+			if height > last {
+				last = height
+			}
+		}
+
+		fmt.Println("read anchor records of entry block", eb.Header.DBHeight)
+		// records are written after the height they anchor
+		if eb.Header.DBHeight < start {
+			break
+		}
+		keymr = eb.Header.PrevKeyMR
+	}
+
+	return func(height int64) (*factom.Anchors, error) {
+		if height > last {
+			return nil, fmt.Errorf("%w: no anchor record after height %d", anchorcost.ErrNotFound, last)
+		}
+		if a, ok := index[height]; ok {
+			return a, nil
+		}
+		return &factom.Anchors{Height: uint32(height)}, nil
+	}, nil
+}
@@ -0,0 +1,207 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/WhoSoup/factom-anchor-cost/anchorcost"
+	"github.com/WhoSoup/factom-anchor-cost/anchorcost/anchorabi"
+)
+
+// config holds the settings shared by every command. It is read from a json
+// file and ANCHORCOST_* environment variables and becomes the defaults of
+// the command flags, so flags still win.
+type config struct {
+	Factomd string     `json:"factomd"`
+	Cache   string     `json:"cache"`
+	BTC     btcConfig  `json:"btc"`
+	Eth     ethConfig  `json:"eth"`
+	Files   fileConfig `json:"files"`
+}
+
+type btcConfig struct {
+	Provider string `json:"provider"`
+	URL      string `json:"url"` // blockchain.info
+	Limit    int    `json:"limit"`
+	RPC      string `json:"rpc"`
+	RPCUser  string `json:"rpc_user"`
+	RPCPass  string `json:"rpc_pass"`
+	Esplora  string `json:"esplora"`
+	Address  string `json:"address"` // the anchor address
+}
+
+type ethConfig struct {
+	Provider string          `json:"provider"`
+	Key      string          `json:"key"`
+	URL      string          `json:"url"` // etherscan
+	Limit    int             `json:"limit"`
+	RPC      string          `json:"rpc"`
+	Address  string          `json:"address"` // the anchor wallet
+	Anchor   ethAnchorConfig `json:"anchor"`
+}
+
+// ethAnchorConfig describes the anchor contract, see anchorabi.Layout
+type ethAnchorConfig struct {
+	Contract  string `json:"contract"`
+	ABI       string `json:"abi"` // json ABI file, empty for the built in one
+	Method    string `json:"method"`
+	MaxHeight string `json:"max_height"`
+	MinHeight string `json:"min_height"`
+	WindowMR  string `json:"window_mr"`
+}
+
+type fileConfig struct {
+	BTCCosts     string `json:"btc_costs"`
+	EthCosts     string `json:"eth_costs"`
+	BTCOut       string `json:"btc_out"`
+	EthOut       string `json:"eth_out"`
+	Journal      string `json:"journal"`
+	Errors       string `json:"errors"`
+	Orphans      string `json:"orphans"`
+	OrphansState string `json:"orphans_state"`
+	EthOrphans   string `json:"eth_orphans"`
+	Verify       string `json:"verify"`
+	BTCDates     string `json:"btc_dates"`
+	EthDates     string `json:"eth_dates"`
+	BTCPrices    string `json:"btc_prices"`
+	EthPrices    string `json:"eth_prices"`
+	BlockTimes   string `json:"blocktimes"`
+	BTCStitch    string `json:"btc_stitch"`
+	EthStitch    string `json:"eth_stitch"`
+	EthSpread    string `json:"eth_spread"`
+}
+
+var defaultConfig = config{
+	Factomd: "localhost:8088",
+	Cache:   "anchorcost.db",
+	BTC: btcConfig{
+		Provider: "blockchain",
+		URL:      anchorcost.BTC_URL,
+		Limit:    anchorcost.BTC_LIMIT,
+		RPC:      "http://localhost:8332",
+		Esplora:  "https://blockstream.info/api",
+		Address:  "1K2SXgApmo9uZoyahvsbSanpVWbzZWVVMF",
+	},
+	Eth: ethConfig{
+		URL:   anchorcost.ETH_URL,
+		Limit: anchorcost.ETH_LIMIT,
+		Anchor: ethAnchorConfig{
+			Contract:  "0xfac701d9554a008e48b6307fb90457ba3959e8a8",
+			Method:    anchorabi.DefaultLayout.Method,
+			MaxHeight: anchorabi.DefaultLayout.MaxHeight,
+			MinHeight: anchorabi.DefaultLayout.MinHeight,
+			WindowMR:  anchorabi.DefaultLayout.WindowMR,
+		},
+	},
+	Files: fileConfig{
+		BTCCosts:     "bitcoin.txt",
+		EthCosts:     "ethereum.txt",
+		BTCOut:       "btc.txt",
+		EthOut:       "eth.txt",
+		Journal:      "scan.journal",
+		Errors:       "scan-errors.txt",
+		Orphans:      "orphans.txt",
+		OrphansState: "orphans.state",
+		EthOrphans:   "eth-orphans.txt",
+		Verify:       "verify.txt",
+		BTCDates:     "bitcoin-dates.txt",
+		EthDates:     "ethereum-dates.txt",
+		BTCPrices:    "Coinbase_BTCUSD_d.csv",
+		EthPrices:    "Coinbase_ETHUSD_d.csv",
+		BlockTimes:   "blocktime.json",
+		BTCStitch:    "btc-stitch.txt",
+		EthStitch:    "eth-stitch.txt",
+		EthSpread:    "ethereum-spread.txt",
+	},
+}
+
+// cfg is the configuration of the running command
+var cfg = defaultConfig
+
+// loadConfig reads the config file at path on top of the defaults and then
+// applies the environment. A missing file is only an error if required.
+func loadConfig(path string, required bool) (config, error) {
+	c := defaultConfig
+
+	data, err := ioutil.ReadFile(path)
+	if err == nil {
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(&c); err != nil {
+			return c, &anchorcost.ParseError{File: path, Err: err}
+		}
+		// a file written by the config command has the secrets redacted
+		if c.Eth.Key == redacted {
+			c.Eth.Key = ""
+		}
+		if c.BTC.RPCPass == redacted {
+			c.BTC.RPCPass = ""
+		}
+	} else if required || !os.IsNotExist(err) {
+		return c, err
+	}
+
+	return c, applyEnv(reflect.ValueOf(&c).Elem(), "ANCHORCOST")
+}
+
+// applyEnv overrides fields with environment variables named after the json
+// keys, like ANCHORCOST_ETH_KEY for eth.key
+func applyEnv(v reflect.Value, prefix string) error {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		name := prefix + "_" + strings.ToUpper(t.Field(i).Tag.Get("json"))
+		f := v.Field(i)
+
+		if f.Kind() == reflect.Struct {
+			if err := applyEnv(f, name); err != nil {
+				return err
+			}
+			continue
+		}
+
+		val, ok := os.LookupEnv(name)
+		if !ok {
+			continue
+		}
+		switch f.Kind() {
+		case reflect.String:
+			f.SetString(val)
+		case reflect.Int:
+			n, err := strconv.Atoi(val)
+			if err != nil {
+				return usagef("%s: %v", name, err)
+			}
+			f.SetInt(int64(n))
+		}
+	}
+	return nil
+}
+
+// redacted replaces secrets that are set, so they end up neither on the
+// screen nor in a config file written from the output
+const redacted = "***"
+
+// showConfig prints the configuration after the file and environment were
+// applied, a starting point for a config file. Secrets are redacted, like
+// they are left out of the flag defaults.
+func showConfig(args []string) error {
+	c := cfg
+	if c.Eth.Key != "" {
+		c.Eth.Key = redacted
+	}
+	if c.BTC.RPCPass != "" {
+		c.BTC.RPCPass = redacted
+	}
+
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = os.Stdout.Write(append(data, '\n'))
+	return err
+}
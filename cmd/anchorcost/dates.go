@@ -0,0 +1,91 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/WhoSoup/factom-anchor-cost/anchorcost"
+)
+
+func dates(args []string) error {
+	fs := flag.NewFlagSet("dates", flag.ExitOnError)
+	chain := fs.String("chain", "btc", "The chain of the cost file: btc or eth")
+	in := fs.String("in", "", "Cost file (default the btc_costs or eth_costs file)")
+	outName := fs.String("out", "", "Output file (default the btc_dates or eth_dates file)")
+	var heights heightRange
+	heights.register(fs)
+	var providers providerFlags
+	providers.registerBTC(fs)
+	providers.registerEth(fs)
+	fs.Parse(args)
+	defer providers.close()
+
+	var getTime func(txid string) (time.Time, error)
+	var header string
+	switch *chain {
+	case "btc":
+		btc, err := providers.btcProvider()
+		if err != nil {
+			return err
+		}
+		getTime = func(txid string) (time.Time, error) { return anchorcost.BTCTime(btc, txid) }
+		header = "Height,TxID,BtcPaid,TxDate"
+		if *in == "" {
+			*in = cfg.Files.BTCCosts
+		}
+		if *outName == "" {
+			*outName = cfg.Files.BTCDates
+		}
+	case "eth":
+		eth, err := providers.ethProvider()
+		if err != nil {
+			return err
+		}
+		getTime = func(txid string) (time.Time, error) { return anchorcost.EthTime(eth, txid) }
+		header = "Height,TxID,EthPaid,TxDate"
+		if *in == "" {
+			*in = cfg.Files.EthCosts
+		}
+		if *outName == "" {
+			*outName = cfg.Files.EthDates
+		}
+	default:
+		return usagef("unknown chain %q", *chain)
+	}
+
+	costs, err := anchorcost.LoadCosts(*in)
+	if err != nil {
+		return err
+	}
+
+	out, err := os.Create(*outName)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+	if _, err := fmt.Fprintln(out, header); err != nil {
+		return err
+	}
+	for i, f := range costs {
+		if !heights.contains(int64(f.Height)) {
+			continue
+		}
+		if interrupted() {
+			return errInterrupted
+		}
+
+		// a missing row would make stitch understate the totals
+		t, err := getTime(f.Hash)
+		if err != nil {
+			return fmt.Errorf("height %d: %s: %w", f.Height, f.Hash, err)
+		}
+
+		if _, err := fmt.Fprintf(out, "%d,%s,%s,%s\n", f.Height, f.Hash, f.Amount, t.Format(anchorcost.TimeFormat)); err != nil {
+			return err
+		}
+		fmt.Println(i, "/", len(costs))
+	}
+	return out.Close()
+}
@@ -0,0 +1,132 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/FactomProject/factom"
+	"github.com/WhoSoup/factom-anchor-cost/anchorcost"
+)
+
+// classes of ethereum transactions that are not anchors factomd knows about
+const (
+	classFailed = "failed" // reverted or out of gas, the gas is paid anyway
+	classOther  = "other"  // not an anchor call
+)
+
+var ethClasses = []string{classCanonical, classDuplicate, classConflicting, classUnknown, classFailed, classOther}
+
+func ethOrphans(args []string) error {
+	fs := flag.NewFlagSet("ethorphans", flag.ExitOnError)
+	wallet := fs.String("addr", cfg.Eth.Address, "The ethereum anchor wallet, empty to skip")
+	contract := fs.String("contract", cfg.Eth.Anchor.Contract, "The anchor contract, empty to skip")
+	outName := fs.String("out", cfg.Files.EthOrphans, "Output file")
+	server := fs.String("s", cfg.Factomd, "The location of the factomd api")
+	pricesName := fs.String("eth-prices", cfg.Files.EthPrices, "Daily ETH/USD prices for the summary, empty to skip")
+	var providers providerFlags
+	providers.registerEth(fs)
+	fs.Parse(args)
+	defer providers.close()
+
+	if *wallet == "" && *contract == "" {
+		return usagef("no wallet or contract address provided")
+	}
+
+	eth, err := providers.ethProvider()
+	if err != nil {
+		return err
+	}
+	history, ok := eth.(anchorcost.EthHistory)
+	if !ok {
+		return usagef("the ethereum provider can not list address transactions, use etherscan")
+	}
+	dec, err := ethAnchorDecoder()
+	if err != nil {
+		return err
+	}
+
+	var prices map[time.Time]float64
+	if *pricesName != "" {
+		if prices, err = anchorcost.LoadPrices(*pricesName); os.IsNotExist(err) {
+			fmt.Println("no price file, skipping USD")
+		} else if err != nil {
+			return err
+		}
+	}
+
+	factom.SetFactomdServer(*server)
+	classifier := newOrphanClassifier(apiAnchors)
+	summary := newEthSummary(prices)
+	defer summary.print()
+
+	out, err := os.Create(*outName)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+	if _, err := fmt.Fprintln(out, "TxID,Block,TxDate,From,To,Nonce,DBHeightMin,DBHeightMax,WindowMR,GasUsed,Cost,Class"); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool)
+	for _, addr := range []string{*wallet, *contract} {
+		if addr == "" {
+			continue
+		}
+
+		for pos := ""; ; {
+			if interrupted() {
+				return errInterrupted
+			}
+
+			txs, next, err := history.AddressTxs(addr, pos)
+			if err != nil {
+				return fmt.Errorf("%s at %q: %w", addr, pos, err)
+			}
+
+			for _, tx := range txs {
+				// only what the wallet paid for and what called the contract,
+				// an empty flag matches nothing, not contract creations
+				paid := *wallet != "" && strings.EqualFold(tx.From, *wallet)
+				called := *contract != "" && strings.EqualFold(tx.To, *contract)
+				if seen[tx.Hash] || !(paid || called) {
+					continue
+				}
+				seen[tx.Hash] = true
+
+				var minCol, maxCol, root string
+				class := classOther
+				if a, err := dec.Anchor(tx.Input); err == nil {
+					minCol, maxCol, root = fmt.Sprint(a.DBHeightMin), fmt.Sprint(a.DBHeightMax), a.WindowMRString()
+					if !tx.Failed {
+						if class, _, err = classifier.classifyEth(tx.Hash, a); err != nil {
+							return fmt.Errorf("%s: %w", tx.Hash, err)
+						}
+					}
+				}
+				if tx.Failed {
+					class = classFailed
+				}
+
+				cost := tx.Cost()
+				summary.add(class, cost, tx.Time)
+
+				t := tx.Time.Format(anchorcost.TimeFormat)
+				if _, err := fmt.Fprintf(out, "%s,%d,%s,%s,%s,%d,%s,%s,%s,%d,%s,%s\n", tx.Hash, tx.BlockNumber, t, tx.From, tx.To, tx.Nonce, minCol, maxCol, root, tx.GasUsed, anchorcost.FormatWei(cost), class); err != nil {
+					return err
+				}
+			}
+
+			if next == "" {
+				break
+			}
+			pos = next
+			fmt.Println(addr, "done", pos)
+		}
+	}
+
+	return out.Close()
+}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+)
+
+// journalEntry is written after every completed height of a scan. Sizes
+// holds the length of each chain's output file at that point, so a resumed
+// scan can cut off rows of a height that did not finish. TxIDs holds the
+// transactions that were recorded at this height, Failed the ones that went
+// into the error report instead.
+type journalEntry struct {
+	Height int64             `json:"height"`
+	Sizes  map[string]int64  `json:"sizes"`
+	TxIDs  map[string]string `json:"txids,omitempty"`
+	Failed map[string]string `json:"failed,omitempty"`
+}
+
+// reportSize is the key of the error report in journalEntry.Sizes
+const reportSize = "errors"
+
+type journal struct {
+	f *os.File
+}
+
+// newJournal starts a new journal, discarding the previous one
+func newJournal(name string) (*journal, error) {
+	f, err := os.Create(name)
+	if err != nil {
+		return nil, err
+	}
+	return &journal{f: f}, nil
+}
+
+// resumeJournal returns all complete entries of a journal and opens it for
+// appending. A partially written last line from a crash is cut off.
+func resumeJournal(name string) (*journal, []journalEntry, error) {
+	f, err := os.OpenFile(name, os.O_RDWR, 0644)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var entries []journalEntry
+	var size int64
+	r := bufio.NewReader(f)
+	for line := 1; ; line++ {
+		data, err := r.ReadBytes('\n')
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			f.Close()
+			return nil, nil, err
+		}
+
+		var e journalEntry
+		if err := json.Unmarshal(data, &e); err != nil {
+			f.Close()
+			return nil, nil, fmt.Errorf("%s:%d: %v", name, line, err)
+		}
+		entries = append(entries, e)
+		size += int64(len(data))
+	}
+
+	if err := f.Truncate(size); err != nil {
+		f.Close()
+		return nil, nil, err
+	}
+	if _, err := f.Seek(size, io.SeekStart); err != nil {
+		f.Close()
+		return nil, nil, err
+	}
+	return &journal{f: f}, entries, nil
+}
+
+func (j *journal) write(e journalEntry) error {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	if _, err = j.f.Write(append(data, '\n')); err != nil {
+		return err
+	}
+	return j.f.Sync()
+}
+
+// truncateTo cuts a file back to the size the journal recorded for it.
+// A file that is shorter lost rows the journal counts as written, it is
+// not padded but refused.
+func truncateTo(f *os.File, size int64) error {
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	if info.Size() < size {
+		return fmt.Errorf("%s is %d bytes, shorter than the %d bytes in the journal, it can not be resumed", f.Name(), info.Size(), size)
+	}
+	return f.Truncate(size)
+}
+
+func (j *journal) Close() error {
+	return j.f.Close()
+}
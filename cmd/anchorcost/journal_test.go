@@ -0,0 +1,36 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestTruncateTo(t *testing.T) {
+	dir, err := ioutil.TempDir("", "journal")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	name := filepath.Join(dir, "out.txt")
+	if err := ioutil.WriteFile(name, []byte("row 1\nrow 2\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	f, err := os.OpenFile(name, os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	if err := truncateTo(f, 20); err == nil {
+		t.Error("file shorter than the journal was not refused")
+	}
+	if err := truncateTo(f, 6); err != nil {
+		t.Fatal(err)
+	}
+	if data, _ := ioutil.ReadFile(name); string(data) != "row 1\n" {
+		t.Errorf("file = %q, want %q", data, "row 1\n")
+	}
+}
@@ -0,0 +1,170 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/WhoSoup/factom-anchor-cost/anchorcost"
+)
+
+type command struct {
+	name  string
+	usage string
+	run   func(args []string) error
+}
+
+var commands = []command{
+	{"scan", "walk factomd heights and record the cost of each anchor", scan},
+	{"orphans", "list all anchor transactions sent from the bitcoin anchor address", orphans},
+	{"ethorphans", "list all transactions of the ethereum anchor wallet and contract, failed ones included", ethOrphans},
+	{"verify", "check anchored KeyMRs against the directory blocks of factomd", verify},
+	{"dates", "add the transaction date to a cost file", dates},
+	{"spread", "split the cost of each ethereum anchor across the heights of its window", spread},
+	{"stitch", "combine dated costs with price and block time data", stitch},
+	{"config", "print the settings from the config file and environment", showConfig},
+}
+
+// exit codes, one per class of failure
+const (
+	exitError       = 1 // anything not listed below
+	exitUsage       = 2
+	exitInput       = 3 // an input file could not be parsed
+	exitNetwork     = 4 // a provider could not be reached or kept rate limiting
+	exitNotFound    = 5 // a provider does not know a transaction or block
+	exitMalformed   = 6 // a provider sent something that could not be read
+	exitCacheMiss   = 7 // offline mode needed data that is not cached
+	exitInterrupted = 130
+)
+
+// usageError is a problem with the flags of a command
+type usageError struct {
+	msg string
+}
+
+func (e *usageError) Error() string {
+	return e.msg
+}
+
+func usagef(format string, args ...interface{}) error {
+	return &usageError{msg: fmt.Sprintf(format, args...)}
+}
+
+// errInterrupted is returned by commands that stopped early on SIGINT
+var errInterrupted = errors.New("interrupted")
+
+// interrupt is closed on the first SIGINT or SIGTERM. Long running commands
+// stop at the next point where their output is complete, a second signal
+// exits right away.
+var interrupt = make(chan struct{})
+
+func interrupted() bool {
+	select {
+	case <-interrupt:
+		return true
+	default:
+		return false
+	}
+}
+
+func exitCode(err error) int {
+	var uerr *usageError
+	var perr *anchorcost.ParseError
+	switch {
+	case errors.Is(err, errInterrupted):
+		return exitInterrupted
+	case errors.As(err, &uerr):
+		return exitUsage
+	case errors.As(err, &perr):
+		return exitInput
+	case errors.Is(err, anchorcost.ErrNetwork), errors.Is(err, anchorcost.ErrRateLimited):
+		return exitNetwork
+	case errors.Is(err, anchorcost.ErrNotFound), errors.Is(err, anchorcost.ErrPending):
+		return exitNotFound
+	case errors.Is(err, anchorcost.ErrMalformed):
+		return exitMalformed
+	case errors.Is(err, anchorcost.ErrCacheMiss):
+		return exitCacheMiss
+	}
+	return exitError
+}
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage: anchorcost [-config file] <command> [flags]\n\nCommands:\n")
+	for _, c := range commands {
+		fmt.Fprintf(os.Stderr, "  %-8s %s\n", c.name, c.usage)
+	}
+	fmt.Fprintf(os.Stderr, "\nRun 'anchorcost <command> -h' for the flags of a command.\n")
+	fmt.Fprintf(os.Stderr, "The config file defaults to $ANCHORCOST_CONFIG or anchorcost.json, every setting\n")
+	fmt.Fprintf(os.Stderr, "can be overridden with ANCHORCOST_* variables, see 'anchorcost config'.\n")
+}
+
+// heightRange adds the -start and -end flags to a command
+type heightRange struct {
+	start int64
+	end   int64
+}
+
+func (r *heightRange) register(fs *flag.FlagSet) {
+	fs.Int64Var(&r.start, "start", 0, "Start height")
+	fs.Int64Var(&r.end, "end", 0, "End height (0 for no limit)")
+}
+
+func (r *heightRange) contains(height int64) bool {
+	if height < r.start {
+		return false
+	}
+	return r.end <= 0 || height <= r.end
+}
+
+func main() {
+	global := flag.NewFlagSet("anchorcost", flag.ExitOnError)
+	global.Usage = usage
+	configPath := global.String("config", "", "Config file")
+	global.Parse(os.Args[1:])
+	if global.NArg() < 1 {
+		usage()
+		os.Exit(exitUsage)
+	}
+	name, args := global.Arg(0), global.Args()[1:]
+
+	path, required := *configPath, true
+	if path == "" {
+		path, required = os.Getenv("ANCHORCOST_CONFIG"), true
+	}
+	if path == "" {
+		path, required = "anchorcost.json", false
+	}
+	var err error
+	if cfg, err = loadConfig(path, required); err != nil {
+		fmt.Fprintf(os.Stderr, "config: %v\n", err)
+		os.Exit(exitCode(err))
+	}
+
+	sig := make(chan os.Signal, 2)
+	signal.Notify(sig, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-sig
+		fmt.Fprintln(os.Stderr, "stopping, interrupt again to exit immediately")
+		close(interrupt)
+		<-sig
+		os.Exit(exitInterrupted)
+	}()
+
+	for _, c := range commands {
+		if c.name == name {
+			if err := c.run(args); err != nil {
+				fmt.Fprintf(os.Stderr, "%s: %v\n", c.name, err)
+				os.Exit(exitCode(err))
+			}
+			return
+		}
+	}
+
+	fmt.Fprintf(os.Stderr, "unknown command %q\n\n", name)
+	usage()
+	os.Exit(exitUsage)
+}
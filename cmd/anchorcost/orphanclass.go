@@ -0,0 +1,193 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/FactomProject/factom"
+	"github.com/WhoSoup/factom-anchor-cost/anchorcost"
+	"github.com/WhoSoup/factom-anchor-cost/anchorcost/anchorabi"
+)
+
+// anchor transaction classes
+const (
+	classCanonical   = "canonical"   // the transaction factomd reports for the height
+	classDuplicate   = "duplicate"   // same height and KeyMR as factomd, different transaction
+	classConflicting = "conflicting" // same height as factomd, different KeyMR
+	classUnknown     = "unknown"     // factomd has no anchor for the height
+)
+
+var classes = []string{classCanonical, classDuplicate, classConflicting, classUnknown}
+
+// orphanClassifier compares anchor transactions to the anchors of factomd
+type orphanClassifier struct {
+	source  anchorSource
+	anchors map[int64]*factom.Anchors // nil if factomd does not have the height
+}
+
+func newOrphanClassifier(source anchorSource) *orphanClassifier {
+	o := new(orphanClassifier)
+	o.source = source
+	o.anchors = make(map[int64]*factom.Anchors)
+	return o
+}
+
+// lookup returns factomd's anchors of a height, nil if it does not have it
+func (o *orphanClassifier) lookup(height int64) (*factom.Anchors, error) {
+	a, ok := o.anchors[height]
+	if !ok {
+		var err error
+		if a, err = o.source(height); errors.Is(err, anchorcost.ErrNotFound) {
+			a = nil
+		} else if err != nil {
+			return nil, fmt.Errorf("height %d: %w", height, err)
+		}
+		o.anchors[height] = a
+	}
+	return a, nil
+}
+
+func (o *orphanClassifier) classify(txid string, height int64, keymr string) (string, error) {
+	a, err := o.lookup(height)
+	if err != nil {
+		return "", err
+	}
+
+	switch {
+	case a == nil || a.Bitcoin == nil:
+		return classUnknown, nil
+	case strings.EqualFold(a.Bitcoin.TransactionHash, txid):
+		return classCanonical, nil
+	case !strings.EqualFold(a.KeyMR, keymr):
+		return classConflicting, nil
+	}
+	return classDuplicate, nil
+}
+
+// classifyEth compares an ethereum anchor call to the window factomd
+// reports for the anchored height. It also returns factomd's anchor, nil if
+// there is none.
+func (o *orphanClassifier) classifyEth(txid string, anchor *anchorabi.Anchor) (string, *factom.AnchorEthereum, error) {
+	a, err := o.lookup(anchor.DBHeightMax)
+	if err != nil {
+		return "", nil, err
+	}
+	if a == nil || a.Ethereum == nil {
+		return classUnknown, nil, nil
+	}
+
+	e := a.Ethereum
+	switch {
+	case strings.EqualFold(strings.TrimPrefix(e.TxID, "0x"), strings.TrimPrefix(txid, "0x")):
+		return classCanonical, e, nil
+	case !ethWindowMatch(e, anchor):
+		return classConflicting, e, nil
+	}
+	return classDuplicate, e, nil
+}
+
+// ethWindowMatch checks that an anchor call commits to the window factomd
+// reports, no matter which transaction factomd names
+func ethWindowMatch(e *factom.AnchorEthereum, anchor *anchorabi.Anchor) bool {
+	switch {
+	case e.DBHeightMax != anchor.DBHeightMax,
+		anchor.DBHeightMin >= 0 && e.DBHeightMin != anchor.DBHeightMin,
+		!strings.EqualFold(strings.TrimPrefix(e.WindowMR, "0x"), anchor.WindowMRString()):
+		return false
+	}
+	return true
+}
+
+// orphanSummary adds up the fees of each class in the smallest unit of the
+// chain. USD amounts use the price of the day the transaction was mined.
+type orphanSummary struct {
+	unit    string                // the coin, "BTC" or "ETH"
+	scale   float64               // smallest units per coin
+	format  func(*big.Int) string // prints an amount in coins
+	classes []string
+	total   string // label of everything but the canonical class
+
+	prices  map[time.Time]float64 // nil to skip USD
+	noPrice int                   // transactions on days without a price
+	count   map[string]int
+	fees    map[string]*big.Int
+	usd     map[string]float64
+}
+
+func newOrphanSummary(prices map[time.Time]float64, unit string, scale float64, format func(*big.Int) string, classes []string, total string) *orphanSummary {
+	s := new(orphanSummary)
+	s.unit = unit
+	s.scale = scale
+	s.format = format
+	s.classes = classes
+	s.total = total
+	s.prices = prices
+	s.count = make(map[string]int)
+	s.fees = make(map[string]*big.Int)
+	s.usd = make(map[string]float64)
+	for _, class := range classes {
+		s.fees[class] = new(big.Int)
+	}
+	return s
+}
+
+// newBTCSummary sums satoshis, the non-canonical transactions are wasted
+func newBTCSummary(prices map[time.Time]float64) *orphanSummary {
+	format := func(v *big.Int) string { return anchorcost.FormatSatoshi(v.Uint64()) }
+	return newOrphanSummary(prices, "BTC", 1e8, format, classes, "wasted")
+}
+
+// newEthSummary sums wei, the non-canonical transactions are unaccounted
+// for by factomd
+func newEthSummary(prices map[time.Time]float64) *orphanSummary {
+	return newOrphanSummary(prices, "ETH", 1e18, anchorcost.FormatWei, ethClasses, "unaccounted")
+}
+
+func (s *orphanSummary) add(class string, fee *big.Int, t time.Time) {
+	s.count[class]++
+	s.fees[class].Add(s.fees[class], fee)
+
+	price, ok := dayPrice(s.prices, t)
+	if s.prices != nil && !ok {
+		s.noPrice++
+	}
+	coins, _ := new(big.Float).Quo(new(big.Float).SetInt(fee), big.NewFloat(s.scale)).Float64()
+	s.usd[class] += coins * price
+}
+
+// dayPrice returns the price of the day of t
+func dayPrice(prices map[time.Time]float64, t time.Time) (float64, bool) {
+	t = t.UTC()
+	price, ok := prices[time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)]
+	return price, ok
+}
+
+func (s *orphanSummary) print() {
+	var count int
+	fees := new(big.Int)
+	var usd float64
+	for _, class := range s.classes {
+		s.printLine(class, s.count[class], s.fees[class], s.usd[class])
+		if class != classCanonical {
+			count += s.count[class]
+			fees.Add(fees, s.fees[class])
+			usd += s.usd[class]
+		}
+	}
+
+	s.printLine(s.total, count, fees, usd)
+	if s.noPrice > 0 {
+		fmt.Println(s.noPrice, "transactions have no price and count as $0")
+	}
+}
+
+func (s *orphanSummary) printLine(label string, count int, fees *big.Int, usd float64) {
+	fmt.Printf("%-12s %6d txs %s %s", label, count, s.format(fees), s.unit)
+	if s.prices != nil {
+		fmt.Printf(" $%.2f", usd)
+	}
+	fmt.Println()
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/FactomProject/factom"
+	"github.com/WhoSoup/factom-anchor-cost/anchorcost"
+	"github.com/WhoSoup/factom-anchor-cost/anchorcost/anchorabi"
+)
+
+func TestClassifyEth(t *testing.T) {
+	root := strings.Repeat("ab", 32)
+	source := func(height int64) (*factom.Anchors, error) {
+		if height != 100 {
+			return nil, anchorcost.ErrNotFound
+		}
+		return &factom.Anchors{Height: 100, Ethereum: &factom.AnchorEthereum{
+			DBHeightMax: 100,
+			DBHeightMin: 91,
+			WindowMR:    "0x" + root,
+			TxID:        "0xCAFE",
+		}}, nil
+	}
+
+	anchor := func(max, min int64, b byte) *anchorabi.Anchor {
+		a := &anchorabi.Anchor{DBHeightMax: max, DBHeightMin: min}
+		for i := range a.WindowMR {
+			a.WindowMR[i] = b
+		}
+		return a
+	}
+
+	tests := []struct {
+		name   string
+		txid   string
+		anchor *anchorabi.Anchor
+		class  string
+		result string
+	}{
+		{"canonical", "0xcafe", anchor(100, 91, 0xab), classCanonical, verifyMatch},
+		{"canonical other root", "cafe", anchor(100, 91, 0xcd), classCanonical, verifyMismatch},
+		{"canonical other min", "0xcafe", anchor(100, 90, 0xab), classCanonical, verifyMismatch},
+		{"duplicate", "0xbeef", anchor(100, 91, 0xab), classDuplicate, verifyMatch},
+		{"duplicate without min", "0xbeef", anchor(100, -1, 0xab), classDuplicate, verifyMatch},
+		{"conflicting", "0xbeef", anchor(100, 91, 0xcd), classConflicting, verifyMismatch},
+		{"unknown", "0xbeef", anchor(101, 92, 0xab), classUnknown, verifyMissing},
+	}
+
+	classifier := newOrphanClassifier(source)
+	for _, tt := range tests {
+		class, e, err := classifier.classifyEth(tt.txid, tt.anchor)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if class != tt.class {
+			t.Errorf("%s: class = %s, want %s", tt.name, class, tt.class)
+		}
+		if result := ethVerifyResult(e, tt.anchor); result != tt.result {
+			t.Errorf("%s: result = %s, want %s", tt.name, result, tt.result)
+		}
+	}
+}
+
+func TestClassify(t *testing.T) {
+	anchors := map[int64]*factom.Anchors{
+		100: {Height: 100, KeyMR: "aa", Bitcoin: &factom.AnchorBitcoin{TransactionHash: "tx1"}},
+		101: {Height: 101, KeyMR: "bb", Ethereum: &factom.AnchorEthereum{TxID: "0xeth"}},
+	}
+	source := func(height int64) (*factom.Anchors, error) {
+		if a, ok := anchors[height]; ok {
+			return a, nil
+		}
+		return nil, anchorcost.ErrNotFound
+	}
+
+	tests := []struct {
+		txid   string
+		height int64
+		keymr  string
+		class  string
+	}{
+		{"TX1", 100, "aa", classCanonical},
+		{"tx2", 100, "AA", classDuplicate},
+		{"tx2", 100, "cc", classConflicting},
+		{"tx2", 101, "cc", classUnknown}, // only anchored to ethereum
+		{"tx2", 102, "aa", classUnknown},
+	}
+
+	classifier := newOrphanClassifier(source)
+	for _, tt := range tests {
+		class, err := classifier.classify(tt.txid, tt.height, tt.keymr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if class != tt.class {
+			t.Errorf("%s at %d: class = %s, want %s", tt.txid, tt.height, class, tt.class)
+		}
+	}
+}
+
+func TestOrphanSummary(t *testing.T) {
+	day := time.Date(2020, 9, 1, 0, 0, 0, 0, time.UTC)
+	s := newBTCSummary(map[time.Time]float64{day: 10000})
+	s.add(classCanonical, big.NewInt(1000), day.Add(time.Hour))
+	s.add(classDuplicate, big.NewInt(2000), day.Add(2*time.Hour))
+	s.add(classDuplicate, big.NewInt(3000), day.AddDate(0, 0, 1))
+
+	if got := s.format(s.fees[classDuplicate]); got != anchorcost.FormatSatoshi(5000) {
+		t.Errorf("duplicate fees %s, want %s", got, anchorcost.FormatSatoshi(5000))
+	}
+	if s.usd[classDuplicate] != 0.2 || s.usd[classCanonical] != 0.1 {
+		t.Errorf("usd %v", s.usd)
+	}
+	if s.noPrice != 1 {
+		t.Errorf("%d without a price, want 1", s.noPrice)
+	}
+}
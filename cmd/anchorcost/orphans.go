@@ -0,0 +1,341 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"math/big"
+	"os"
+	"time"
+
+	"github.com/FactomProject/factom"
+	"github.com/WhoSoup/factom-anchor-cost/anchorcost"
+	"github.com/WhoSoup/factom-anchor-cost/anchorcost/anchorscript"
+)
+
+// orphanState is saved after every page of the address history so an
+// unfinished crawl can be resumed and a finished one updated with new
+// transactions
+type orphanState struct {
+	Addr     string `json:"addr"`
+	Cursor   string `json:"cursor"`   // the next page of an unfinished crawl
+	Size     int64  `json:"size"`     // the length of the output up to Cursor
+	Newest   string `json:"newest"`   // the newest transaction already in the output
+	Complete bool   `json:"complete"` // the crawl reached the end of the history
+}
+
+func loadOrphanState(name string) (*orphanState, error) {
+	data, err := ioutil.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+	s := new(orphanState)
+	if err := json.Unmarshal(data, s); err != nil {
+		return nil, &anchorcost.ParseError{File: name, Err: err}
+	}
+	return s, nil
+}
+
+// save replaces the state file, going through a temporary file so an
+// interruption never leaves half a state behind
+func (s *orphanState) save(name string) error {
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(name+".tmp", data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(name+".tmp", name)
+}
+
+// orphanOutput writes the rows of the orphans file and skips transactions
+// that are already in it
+type orphanOutput struct {
+	f    *os.File
+	size int64
+	seen map[string]bool
+}
+
+func createOrphanOutput(name string) (*orphanOutput, error) {
+	f, err := os.Create(name)
+	if err != nil {
+		return nil, err
+	}
+	o := &orphanOutput{f: f, seen: make(map[string]bool)}
+	if err := o.write("TxID,Height,KeyMR,TxDate,Fee,Class\n"); err != nil {
+		f.Close()
+		return nil, err
+	}
+	return o, nil
+}
+
+// reopenOrphanOutput cuts off anything written after the state was saved and
+// appends to the rest. The rows that are kept are added to the summary, so
+// it covers the whole file and not only this run.
+func reopenOrphanOutput(name string, size int64, summary *orphanSummary) (*orphanOutput, error) {
+	if err := os.Truncate(name, size); err != nil {
+		return nil, err
+	}
+	anchors, err := anchorcost.LoadAnchors(name)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.OpenFile(name, os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, err
+	}
+
+	o := &orphanOutput{f: f, size: size, seen: make(map[string]bool)}
+	for _, a := range anchors {
+		o.seen[a.TxID] = true
+
+		// rows without a fee were counted as zero when they were written
+		var fee uint64
+		if a.Fee != "" {
+			if fee, err = anchorcost.ParseSatoshi(a.Fee); err != nil {
+				f.Close()
+				return nil, &anchorcost.ParseError{File: name, Err: fmt.Errorf("%s: %w", a.TxID, err)}
+			}
+		}
+		summary.add(a.Class, new(big.Int).SetUint64(fee), a.TxTime)
+	}
+	return o, nil
+}
+
+func (o *orphanOutput) write(line string) error {
+	n, err := o.f.WriteString(line)
+	o.size += int64(n)
+	return err
+}
+
+func orphans(args []string) error {
+	fs := flag.NewFlagSet("orphans", flag.ExitOnError)
+	cursor := fs.String("cursor", "", "Position in the address history to start at, an offset for blockchain")
+	addr := fs.String("addr", cfg.BTC.Address, "The bitcoin anchor address")
+	outName := fs.String("out", cfg.Files.Orphans, "Output file")
+	stateName := fs.String("state", cfg.Files.OrphansState, "Crawl state file")
+	resume := fs.Bool("resume", false, "Continue an unfinished crawl from the state file")
+	update := fs.Bool("new", false, "Add the transactions made since the last finished crawl")
+	server := fs.String("s", cfg.Factomd, "The location of the factomd api")
+	sourceName := fs.String("source", "anchors", "Where factomd's anchors come from: anchors (the factomd anchors api) or chain (the anchor chain)")
+	pricesName := fs.String("btc-prices", cfg.Files.BTCPrices, "Daily BTC/USD prices for the summary, empty to skip")
+	var providers providerFlags
+	providers.registerBTC(fs)
+	fs.Parse(args)
+	defer providers.close()
+
+	state := &orphanState{Addr: *addr, Cursor: *cursor}
+	if *resume || *update {
+		if *resume && *update {
+			return usagef("-resume and -new can not be combined")
+		}
+		var err error
+		if state, err = loadOrphanState(*stateName); err != nil {
+			return err
+		}
+		switch {
+		case state.Addr != *addr:
+			return usagef("%s is the state of address %s", *stateName, state.Addr)
+		case *resume && state.Complete:
+			fmt.Println("the crawl is complete, use -new to add new transactions")
+			return nil
+		case *update && !state.Complete:
+			return usagef("the crawl is not complete, finish it with -resume first")
+		case *update && state.Newest == "":
+			return usagef("the crawl did not start at the newest transaction, run it again without -cursor")
+		}
+	}
+
+	btc, err := providers.btcProvider()
+	if err != nil {
+		return err
+	}
+
+	var prices map[time.Time]float64
+	if *pricesName != "" {
+		if prices, err = anchorcost.LoadPrices(*pricesName); os.IsNotExist(err) {
+			fmt.Println("no price file, skipping USD")
+		} else if err != nil {
+			return err
+		}
+	}
+
+	factom.SetFactomdServer(*server)
+	var source anchorSource
+	switch *sourceName {
+	case "anchors":
+		source = apiAnchors
+	case "chain":
+		if source, err = chainAnchors(0); err != nil {
+			return err
+		}
+	default:
+		return usagef("unknown anchor source %q", *sourceName)
+	}
+	classifier := newOrphanClassifier(source)
+	summary := newBTCSummary(prices)
+	defer summary.print()
+
+	var out *orphanOutput
+	if *resume || *update {
+		out, err = reopenOrphanOutput(*outName, state.Size, summary)
+	} else {
+		out, err = createOrphanOutput(*outName)
+	}
+	if err != nil {
+		return err
+	}
+	defer out.f.Close()
+
+	// add writes the row of an anchor transaction that is not in the output yet
+	add := func(tx *anchorcost.BTCTx) error {
+		if out.seen[tx.Hash] {
+			return nil
+		}
+		out.seen[tx.Hash] = true
+
+		a, err := txAnchor(tx)
+		if errors.Is(err, anchorscript.ErrNoAnchor) {
+			return nil
+		} else if err != nil {
+			log.Println(err)
+			return nil
+		}
+		height, keymr := a.Height, a.KeyMRString()
+
+		class, err := classifier.classify(tx.Hash, height, keymr)
+		if err != nil {
+			return fmt.Errorf("%s: %w", tx.Hash, err)
+		}
+
+		var feeCol string
+		fee, err := tx.Fee()
+		if err != nil {
+			fmt.Println("no fee for", tx.Hash, err)
+		} else {
+			feeCol = anchorcost.FormatSatoshi(fee)
+		}
+		summary.add(class, new(big.Int).SetUint64(fee), tx.Time)
+
+		t := tx.Time.Format(anchorcost.TimeFormat)
+		return out.write(fmt.Sprintf("%s,%d,%s,%s,%s,%s\n", tx.Hash, height, keymr, t, feeCol, class))
+	}
+
+	if *update {
+		err = crawlNew(btc, state, add)
+	} else {
+		err = crawl(btc, state, *stateName, out, add)
+	}
+	if err != nil {
+		return err
+	}
+
+	if *update {
+		// the new rows only count once the whole gap is filled
+		state.Size = out.size
+		if err := state.save(*stateName); err != nil {
+			return err
+		}
+	}
+	return out.f.Close()
+}
+
+// crawl walks the address history from the state's cursor to its end and
+// saves the state after every page. The history is newest first, so
+// transactions that arrive during the crawl shift the offsets and show up
+// again on the next page, where the output skips them.
+func crawl(btc anchorcost.BTCProvider, state *orphanState, stateName string, out *orphanOutput, add func(*anchorcost.BTCTx) error) error {
+	for {
+		if interrupted() {
+			fmt.Println("interrupted, continue with -resume")
+			return errInterrupted
+		}
+
+		// the provider already retried anything that was worth retrying
+		txs, next, err := btc.AddressTxs(state.Addr, state.Cursor)
+		if err != nil {
+			return fmt.Errorf("%s at %q: %w", state.Addr, state.Cursor, err)
+		}
+		if state.Cursor == "" && len(txs) > 0 {
+			state.Newest = txs[0].Hash
+		}
+
+		for _, tx := range txs {
+			if err := add(tx); err != nil {
+				return err
+			}
+		}
+
+		state.Cursor, state.Size, state.Complete = next, out.size, next == ""
+		if err := out.f.Sync(); err != nil {
+			return err
+		}
+		if err := state.save(stateName); err != nil {
+			return err
+		}
+		if next == "" {
+			fmt.Println("reached the end of the history")
+			return nil
+		}
+		fmt.Println("done", next)
+	}
+}
+
+// crawlNew walks the history from the newest transaction back to the newest
+// one of the last run. It starts over if it is interrupted, the state only
+// moves once it is done.
+func crawlNew(btc anchorcost.BTCProvider, state *orphanState, add func(*anchorcost.BTCTx) error) error {
+	var pos, newest string
+	for {
+		if interrupted() {
+			fmt.Println("interrupted, nothing was added")
+			return errInterrupted
+		}
+
+		txs, next, err := btc.AddressTxs(state.Addr, pos)
+		if err != nil {
+			return fmt.Errorf("%s at %q: %w", state.Addr, pos, err)
+		}
+		if pos == "" && len(txs) > 0 {
+			newest = txs[0].Hash
+		}
+
+		for _, tx := range txs {
+			if tx.Hash == state.Newest {
+				next = ""
+				break
+			}
+			if err := add(tx); err != nil {
+				return err
+			}
+		}
+
+		if next == "" {
+			break
+		}
+		pos = next
+		fmt.Println("done", pos)
+	}
+
+	if newest != "" {
+		state.Newest = newest
+	}
+	return nil
+}
+
+// txAnchor finds the anchor output of a transaction
+func txAnchor(tx *anchorcost.BTCTx) (*anchorscript.Anchor, error) {
+	scripts := make([][]byte, len(tx.Outputs))
+	for i, o := range tx.Outputs {
+		scripts[i] = o.Script
+	}
+	a, _, err := anchorscript.Find(scripts)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", tx.Hash, err)
+	}
+	return a, nil
+}
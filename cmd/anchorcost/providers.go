@@ -0,0 +1,149 @@
+package main
+
+import (
+	"flag"
+	"io/ioutil"
+
+	"github.com/WhoSoup/factom-anchor-cost/anchorcost"
+	"github.com/WhoSoup/factom-anchor-cost/anchorcost/anchorabi"
+)
+
+// providerFlags adds the flags that select where chain data comes from
+type providerFlags struct {
+	btc     string
+	btcRPC  string
+	btcUser string
+	btcPass string
+	esplora string
+	eth     string
+	ethKey  string
+	ethRPC  string
+
+	cachePath string
+	offline   bool
+	cache     *anchorcost.Cache
+}
+
+// registerCache adds the flags shared by both chains
+func (pf *providerFlags) registerCache(fs *flag.FlagSet) {
+	if fs.Lookup("cache") != nil {
+		return
+	}
+	fs.StringVar(&pf.cachePath, "cache", cfg.Cache, "Cache file for provider responses, empty to disable")
+	fs.BoolVar(&pf.offline, "offline", false, "Only use cached provider responses")
+}
+
+// The password and api key default to the config in btcProvider and
+// ethProvider so -h does not print them.
+
+func (pf *providerFlags) registerBTC(fs *flag.FlagSet) {
+	pf.registerCache(fs)
+	fs.StringVar(&pf.btc, "btc-provider", cfg.BTC.Provider, "Bitcoin data source: blockchain, bitcoind or esplora")
+	fs.StringVar(&pf.btcRPC, "btc-rpc", cfg.BTC.RPC, "The location of the bitcoind json-rpc api")
+	fs.StringVar(&pf.btcUser, "btc-rpc-user", cfg.BTC.RPCUser, "The bitcoind rpc username")
+	fs.StringVar(&pf.btcPass, "btc-rpc-pass", "", "The bitcoind rpc password (default from the config)")
+	fs.StringVar(&pf.esplora, "esplora", cfg.BTC.Esplora, "The location of the esplora api")
+}
+
+func (pf *providerFlags) registerEth(fs *flag.FlagSet) {
+	pf.registerCache(fs)
+	fs.StringVar(&pf.eth, "eth-provider", cfg.Eth.Provider, "Ethereum data source: etherscan or node (default node if -eth-rpc is set)")
+	fs.StringVar(&pf.ethKey, "eth", "", "The API key for etherscan.io (default from the config)")
+	fs.StringVar(&pf.ethRPC, "eth-rpc", cfg.Eth.RPC, "The location of an ethereum node's json-rpc api")
+}
+
+// setCache opens the cache on first use and hands it to the provider
+func (pf *providerFlags) setCache(provider interface{ SetCache(*anchorcost.Cache) }) error {
+	if pf.cache == nil {
+		if pf.cachePath == "" {
+			if pf.offline {
+				return usagef("offline mode requires a cache")
+			}
+			return nil
+		}
+		var err error
+		if pf.cache, err = anchorcost.OpenCache(pf.cachePath, pf.offline); err != nil {
+			return err
+		}
+	}
+	provider.SetCache(pf.cache)
+	return nil
+}
+
+func (pf *providerFlags) close() {
+	pf.cache.Close()
+}
+
+func (pf *providerFlags) btcProvider() (anchorcost.BTCProvider, error) {
+	switch pf.btc {
+	case "blockchain":
+		b := anchorcost.NewBlockchainInfo(cfg.BTC.URL, cfg.BTC.Limit)
+		return b, pf.setCache(b)
+	case "bitcoind":
+		pass := pf.btcPass
+		if pass == "" {
+			pass = cfg.BTC.RPCPass
+		}
+		b := anchorcost.NewBitcoind(pf.btcRPC, pf.btcUser, pass)
+		return b, pf.setCache(b)
+	case "esplora":
+		e := anchorcost.NewEsplora(pf.esplora)
+		return e, pf.setCache(e)
+	}
+	return nil, usagef("unknown bitcoin provider %q", pf.btc)
+}
+
+func (pf *providerFlags) ethProvider() (anchorcost.EthProvider, error) {
+	name := pf.eth
+	if name == "" {
+		name = "etherscan"
+		if pf.ethRPC != "" {
+			name = "node"
+		}
+	}
+
+	switch name {
+	case "node":
+		if pf.ethRPC == "" {
+			return nil, usagef("no eth node provided")
+		}
+		e := anchorcost.NewEthNode(pf.ethRPC)
+		return e, pf.setCache(e)
+	case "etherscan":
+		key := pf.ethKey
+		if key == "" {
+			key = cfg.Eth.Key
+		}
+		if key == "" && !pf.offline {
+			return nil, usagef("no eth api key provided")
+		}
+		e := anchorcost.NewEthscan(key, cfg.Eth.URL, cfg.Eth.Limit)
+		return e, pf.setCache(e)
+	}
+	return nil, usagef("unknown ethereum provider %q", name)
+}
+
+// ethAnchorDecoder reads anchor contract calls with the ABI and layout of
+// the config
+func ethAnchorDecoder() (*anchorabi.Decoder, error) {
+	c := cfg.Eth.Anchor
+	name, abi := "built in abi", []byte(anchorabi.DefaultABI)
+	if c.ABI != "" {
+		name = c.ABI
+		var err error
+		if abi, err = ioutil.ReadFile(c.ABI); err != nil {
+			return nil, err
+		}
+	}
+
+	dec, err := anchorabi.NewDecoder(abi, anchorabi.Layout{
+		Method:    c.Method,
+		MaxHeight: c.MaxHeight,
+		MinHeight: c.MinHeight,
+		WindowMR:  c.WindowMR,
+	})
+	if err != nil {
+		return nil, &anchorcost.ParseError{File: name, Err: err}
+	}
+	return dec, nil
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/WhoSoup/factom-anchor-cost/anchorcost"
+)
+
+// errorReport lists the heights and transactions a scan gave up on, so they
+// can be looked into and scanned again later
+type errorReport struct {
+	out  *os.File
+	size int64 // bytes written to out
+}
+
+func createReport(name string) (*errorReport, error) {
+	out, err := os.Create(name)
+	if err != nil {
+		return nil, err
+	}
+	n, err := fmt.Fprintln(out, "Height, Chain, TxID, Class, Error")
+	return &errorReport{out: out, size: int64(n)}, err
+}
+
+// reopenReport continues the report of an interrupted scan, see
+// chainScan.reopen
+func reopenReport(name string, size int64) (*errorReport, error) {
+	out, err := os.OpenFile(name, os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, err
+	}
+	if err := truncateTo(out, size); err != nil {
+		out.Close()
+		return nil, err
+	}
+	return &errorReport{out: out, size: size}, nil
+}
+
+// add records a failure. Chain and txid are empty if the height itself
+// could not be fetched.
+func (r *errorReport) add(height int64, chain, txid string, err error) error {
+	class := "error"
+	if c := anchorcost.Class(err); c != nil {
+		class = c.Error()
+	}
+	fmt.Println("ERROR", height, chain, txid, err)
+	n, werr := fmt.Fprintf(r.out, "%d, %s, %s, %s, %q\n", height, chain, txid, class, err.Error())
+	r.size += int64(n)
+	return werr
+}
+
+func (r *errorReport) Close() error {
+	return r.out.Close()
+}
@@ -0,0 +1,330 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/FactomProject/factom"
+	"github.com/WhoSoup/factom-anchor-cost/anchorcost"
+)
+
+// chainScan holds the output and the dedup state of one chain. Workers
+// fetch costs through lookup, only the writer calls record.
+type chainScan struct {
+	name string
+	out  *os.File
+	size int64                        // bytes written to out
+	done map[int]bool                 // heights recorded by a previous run
+	pick func(*factom.Anchors) string // the chain's txid of an anchor, if any
+	cost func(txid string) (string, error)
+
+	mtx     sync.Mutex
+	cache   map[string]bool      // transactions that were already recorded
+	pending map[string]*costCall // costs fetched but not yet recorded
+}
+
+// costCall is a cost lookup that other workers can wait on
+type costCall struct {
+	ready chan struct{}
+	line  string
+	err   error
+}
+
+func newChainScan(name, doneName string, pick func(*factom.Anchors) string, cost func(txid string) (string, error)) (*chainScan, error) {
+	c := new(chainScan)
+	c.name = name
+	c.cache = make(map[string]bool)
+	c.pending = make(map[string]*costCall)
+	c.pick = pick
+	c.cost = cost
+
+	var err error
+	c.done, err = anchorcost.LoadHeights(doneName)
+	if os.IsNotExist(err) {
+		c.done = make(map[int]bool)
+	} else if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// create starts a new output file
+func (c *chainScan) create(outName, header string) error {
+	var err error
+	if c.out, err = os.Create(outName); err != nil {
+		return err
+	}
+	n, err := fmt.Fprintln(c.out, header)
+	c.size = int64(n)
+	return err
+}
+
+// reopen continues the output file of an interrupted scan. Anything after
+// size was written for a height that did not complete.
+func (c *chainScan) reopen(outName string, size int64) error {
+	var err error
+	if c.out, err = os.OpenFile(outName, os.O_WRONLY|os.O_APPEND, 0644); err != nil {
+		return err
+	}
+	c.size = size
+	return truncateTo(c.out, size)
+}
+
+// lookup returns the cost columns of a transaction. Each transaction is
+// only fetched once, no matter how many workers ask for it. Transactions
+// that were already recorded return an empty line.
+func (c *chainScan) lookup(height int64, txid string) (string, error) {
+	if c.done[int(height)] {
+		return "", nil
+	}
+
+	c.mtx.Lock()
+	if c.cache[txid] {
+		c.mtx.Unlock()
+		return "", nil
+	}
+	call, ok := c.pending[txid]
+	if !ok {
+		call = &costCall{ready: make(chan struct{})}
+		c.pending[txid] = call
+	}
+	c.mtx.Unlock()
+
+	if ok {
+		<-call.ready
+	} else {
+		call.line, call.err = c.cost(txid)
+		close(call.ready)
+	}
+	return call.line, call.err
+}
+
+// record writes the cost of an anchor transaction the first time it is seen
+// and reports whether a row was written. Heights have to be recorded in
+// order.
+func (c *chainScan) record(height int64, txid, line string) (bool, error) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if c.done[int(height)] || c.cache[txid] {
+		return false, nil
+	}
+	c.cache[txid] = true
+	delete(c.pending, txid)
+
+	n, err := fmt.Fprintf(c.out, "%d, %s, %s\n", height, txid, line)
+	c.size += int64(n)
+	return true, err
+}
+
+// fail marks a transaction whose cost could not be fetched as seen, so later
+// heights anchored by it do not report it again. It reports whether this is
+// the first time the transaction was seen.
+func (c *chainScan) fail(height int64, txid string) bool {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if c.done[int(height)] || c.cache[txid] {
+		return false
+	}
+	c.cache[txid] = true
+	delete(c.pending, txid)
+	return true
+}
+
+// requeue forgets the failed lookup of a transaction that is not mined yet,
+// so the next height that asks for it fetches it again
+func (c *chainScan) requeue(txid string) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	delete(c.pending, txid)
+}
+
+func scan(args []string) error {
+	fs := flag.NewFlagSet("scan", flag.ExitOnError)
+	server := fs.String("s", cfg.Factomd, "The location of the factomd api")
+	chains := fs.String("chains", "eth", "Comma separated list of chains to scan: btc, eth")
+	sourceName := fs.String("source", "anchors", "Where anchors come from: anchors (the factomd anchors api) or chain (the anchor chain, btc only)")
+	ethDone := fs.String("eth-done", cfg.Files.EthCosts, "Ethereum cost file of heights to skip")
+	btcDone := fs.String("btc-done", cfg.Files.BTCCosts, "Bitcoin cost file of heights to skip")
+	ethOut := fs.String("eth-out", cfg.Files.EthOut, "Ethereum output file")
+	btcOut := fs.String("btc-out", cfg.Files.BTCOut, "Bitcoin output file")
+	reportName := fs.String("errors", cfg.Files.Errors, "Report of heights and transactions that failed")
+	journalName := fs.String("journal", cfg.Files.Journal, "Progress journal used by -resume")
+	resume := fs.Bool("resume", false, "Continue the scan recorded in the journal")
+	workers := fs.Int("workers", 4, "Number of heights to fetch in parallel")
+	var heights heightRange
+	heights.register(fs)
+	var providers providerFlags
+	providers.registerBTC(fs)
+	providers.registerEth(fs)
+	fs.Parse(args)
+	defer providers.close()
+
+	var scans []*chainScan
+	outNames := make(map[string]string)
+	headers := make(map[string]string)
+	for _, chain := range strings.Split(*chains, ",") {
+		var c *chainScan
+		switch chain = strings.TrimSpace(chain); chain {
+		case "btc":
+			btc, err := providers.btcProvider()
+			if err != nil {
+				return err
+			}
+			c, err = newChainScan(chain, *btcDone, func(a *factom.Anchors) string {
+				if a.Bitcoin == nil {
+					return ""
+				}
+				return a.Bitcoin.TransactionHash
+			}, func(txid string) (string, error) { return doBTC(btc, txid) })
+			if err != nil {
+				return err
+			}
+			outNames[chain] = *btcOut
+			headers[chain] = "Height, TxID, BTC Fee, Inputs, Change, OpReturn"
+		case "eth":
+			eth, err := providers.ethProvider()
+			if err != nil {
+				return err
+			}
+			c, err = newChainScan(chain, *ethDone, func(a *factom.Anchors) string {
+				if a.Ethereum == nil {
+					return ""
+				}
+				return a.Ethereum.TxID
+			}, func(txid string) (string, error) { return doEth(eth, txid) })
+			if err != nil {
+				return err
+			}
+			outNames[chain] = *ethOut
+			headers[chain] = "Height, TxID, Eth Paid, Burned, Tip, Type"
+		default:
+			return usagef("unknown chain %q", chain)
+		}
+		scans = append(scans, c)
+	}
+
+	switch *sourceName {
+	case "anchors":
+	case "chain":
+		for _, c := range scans {
+			if c.name != "btc" {
+				return usagef("the anchor chain only has bitcoin anchors, use -chains btc")
+			}
+		}
+	default:
+		return usagef("unknown anchor source %q", *sourceName)
+	}
+
+	start := heights.start
+	if start < 0 {
+		start = 0
+	}
+
+	var jrnl *journal
+	var entries []journalEntry
+	var err error
+	if *resume {
+		jrnl, entries, err = resumeJournal(*journalName)
+	} else {
+		jrnl, err = newJournal(*journalName)
+	}
+	if err != nil {
+		return err
+	}
+	defer jrnl.Close()
+
+	var report *errorReport
+	if len(entries) > 0 {
+		last := entries[len(entries)-1]
+		start = last.Height + 1
+		for _, c := range scans {
+			size, ok := last.Sizes[c.name]
+			if !ok {
+				return usagef("journal %s has no %s output to resume", *journalName, c.name)
+			}
+			if err := c.reopen(outNames[c.name], size); err != nil {
+				return err
+			}
+			defer c.out.Close()
+			for _, e := range entries {
+				if txid, ok := e.TxIDs[c.name]; ok {
+					c.cache[txid] = true
+				}
+				if txid, ok := e.Failed[c.name]; ok {
+					c.cache[txid] = true
+				}
+			}
+		}
+		if size, ok := last.Sizes[reportSize]; ok {
+			report, err = reopenReport(*reportName, size)
+		} else {
+			report, err = createReport(*reportName)
+		}
+		fmt.Println("resuming at height", start)
+	} else {
+		for _, c := range scans {
+			if err := c.create(outNames[c.name], headers[c.name]); err != nil {
+				return err
+			}
+			defer c.out.Close()
+		}
+		report, err = createReport(*reportName)
+	}
+	if err != nil {
+		return err
+	}
+	defer report.Close()
+
+	factom.SetFactomdServer(*server)
+
+	var source anchorSource
+	switch *sourceName {
+	case "anchors":
+		source = apiAnchors
+	case "chain":
+		if source, err = chainAnchors(start); err != nil {
+			return err
+		}
+	}
+
+	if err := runScan(start, heights, *workers, source, scans, report, jrnl); err != nil {
+		return err
+	}
+
+	// closed here to catch write errors, the deferred closes are for the
+	// error paths
+	for _, c := range scans {
+		if err := c.out.Close(); err != nil {
+			return err
+		}
+	}
+	if err := report.Close(); err != nil {
+		return err
+	}
+	return jrnl.Close()
+}
+
+// doEth returns the paid, burned, tip and type columns of a transaction
+func doEth(eth anchorcost.EthProvider, txid string) (string, error) {
+	b, err := anchorcost.EthFee(eth, txid)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s, %s, %s, %d", anchorcost.FormatWei(b.Total), anchorcost.FormatWei(b.Burned),
+		anchorcost.FormatWei(b.Tip), b.Type), nil
+}
+
+// doBTC returns the fee, inputs, change and OP_RETURN columns of a transaction
+func doBTC(btc anchorcost.BTCProvider, txid string) (string, error) {
+	b, err := anchorcost.BTCFee(btc, txid)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s, %s, %s, %s", anchorcost.FormatSatoshi(b.Fee), anchorcost.FormatSatoshi(b.Inputs),
+		anchorcost.FormatSatoshi(b.Change), anchorcost.FormatSatoshi(b.OpReturn)), nil
+}
@@ -0,0 +1,210 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"sync"
+
+	"github.com/FactomProject/factom"
+	"github.com/WhoSoup/factom-anchor-cost/anchorcost"
+)
+
+// heightResult is everything the writer needs to record one height
+type heightResult struct {
+	height int64
+	txids  []string // per chainScan, empty if the height has no anchor
+	lines  []string
+	errs   []error // per chainScan, the cost lookups that failed
+	err    error   // the anchors of the height could not be fetched
+}
+
+// factomErr sorts factomd errors into the anchorcost error classes
+func factomErr(err error) error {
+	var jerr *factom.JSONError
+	var uerr *url.Error
+	switch {
+	case errors.As(err, &jerr) && jerr.Code == -32008: // block not found
+		return fmt.Errorf("%w: %v", anchorcost.ErrNotFound, err)
+	case errors.As(err, &uerr):
+		return fmt.Errorf("%w: %v", anchorcost.ErrNetwork, err)
+	}
+	return err
+}
+
+// fetchHeight looks up the anchors of a height and the cost of every new
+// anchor transaction
+func fetchHeight(height int64, source anchorSource, scans []*chainScan) *heightResult {
+	res := &heightResult{height: height, txids: make([]string, len(scans)), lines: make([]string, len(scans)), errs: make([]error, len(scans))}
+
+	anchor, err := source(height)
+	if res.err = err; err != nil {
+		return res
+	}
+
+	for i, c := range scans {
+		if res.txids[i] = c.pick(anchor); res.txids[i] == "" {
+			continue
+		}
+		res.lines[i], res.errs[i] = c.lookup(height, res.txids[i])
+	}
+	return res
+}
+
+// runScan fetches heights with a pool of workers and records them in order.
+// The scan stops at the first height that does not exist or whose anchors
+// still fail on the network after the retries, after all heights before it
+// have been recorded. It also stops after the current height on an
+// interrupt.
+func runScan(start int64, heights heightRange, workers int, source anchorSource, scans []*chainScan, report *errorReport, jrnl *journal) error {
+	if workers < 1 {
+		workers = 1
+	}
+
+	quit := make(chan struct{})
+	// limits how far workers can get ahead of the writer
+	window := make(chan struct{}, workers*4)
+	todo := make(chan int64)
+	results := make(chan *heightResult)
+
+	go func() {
+		defer close(todo)
+		for i := start; heights.contains(i); i++ {
+			select {
+			case window <- struct{}{}:
+			case <-quit:
+				return
+			}
+			select {
+			case todo <- i:
+			case <-quit:
+				return
+			}
+		}
+	}()
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for height := range todo {
+				select {
+				case results <- fetchHeight(height, source, scans):
+				case <-quit:
+					return
+				}
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	// workers may still be waiting on a provider, they are not waited for
+	// so an interrupt does not have to sit out their retries
+	defer func() {
+		close(quit)
+		go func() {
+			for range results {
+			}
+		}()
+	}()
+
+	next := start
+	waiting := make(map[int64]*heightResult)
+	for {
+		var res *heightResult
+		var ok bool
+		select {
+		case res, ok = <-results:
+			if !ok {
+				return nil
+			}
+		case <-interrupt:
+			fmt.Println("interrupted before height", next, "continue with -resume")
+			return errInterrupted
+		}
+		waiting[res.height] = res
+
+		for r, ok := waiting[next]; ok; r, ok = waiting[next] {
+			delete(waiting, next)
+			if cont, err := recordHeight(r, scans, report, jrnl); err != nil || !cont {
+				return err
+			}
+			<-window
+			next++
+		}
+	}
+}
+
+// recordHeight writes the rows and journal entry of a height and reports
+// whether the scan can continue. A height whose anchors are still rate
+// limited or unreachable after the retries stops the scan, so it can be
+// resumed there. Other failures go into the report and the scan moves on.
+func recordHeight(r *heightResult, scans []*chainScan, report *errorReport, jrnl *journal) (bool, error) {
+	if errors.Is(r.err, anchorcost.ErrNotFound) {
+		fmt.Println("height", r.height, "does not exist yet, stopping")
+		return false, nil
+	}
+	if anchorcost.Retryable(r.err) {
+		return false, fmt.Errorf("height %d: %w, continue with -resume", r.height, r.err)
+	}
+
+	entry := journalEntry{Height: r.height, Sizes: make(map[string]int64), TxIDs: make(map[string]string), Failed: make(map[string]string)}
+	if r.err != nil {
+		if err := report.add(r.height, "", "", r.err); err != nil {
+			return false, err
+		}
+	}
+	for i, c := range scans {
+		if r.err == nil && r.txids[i] != "" {
+			if err := recordChain(r, i, c, report, entry); err != nil {
+				return false, fmt.Errorf("height %d: %s %s: %w", r.height, c.name, r.txids[i], err)
+			}
+		}
+		entry.Sizes[c.name] = c.size
+	}
+	entry.Sizes[reportSize] = report.size
+
+	// the rows have to be on disk before the journal counts them
+	for _, c := range scans {
+		if err := c.out.Sync(); err != nil {
+			return false, err
+		}
+	}
+	if err := report.out.Sync(); err != nil {
+		return false, err
+	}
+	if err := jrnl.write(entry); err != nil {
+		return false, err
+	}
+	fmt.Println("height", r.height, "done")
+	return true, nil
+}
+
+// recordChain writes the row or error of one chain's anchor of a height
+func recordChain(r *heightResult, i int, c *chainScan, report *errorReport, entry journalEntry) error {
+	txid, err := r.txids[i], r.errs[i]
+	switch {
+	case errors.Is(err, anchorcost.ErrPending):
+		// not marked as seen, later heights with the same transaction
+		// look it up again
+		c.requeue(txid)
+		return report.add(r.height, c.name, txid, err)
+	case err != nil:
+		if !c.fail(r.height, txid) {
+			return nil
+		}
+		entry.Failed[c.name] = txid
+		return report.add(r.height, c.name, txid, err)
+	}
+
+	written, err := c.record(r.height, txid, r.lines[i])
+	if written {
+		entry.TxIDs[c.name] = txid
+	}
+	return err
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/FactomProject/factom"
+	"github.com/WhoSoup/factom-anchor-cost/anchorcost"
+)
+
+// spread attributes the cost of every ethereum anchor to the heights of its
+// window, so the per height costs can be compared to bitcoin's. The output
+// is a cost file with a row per height that stitch reads as -eth-in.
+func spread(args []string) error {
+	fs := flag.NewFlagSet("spread", flag.ExitOnError)
+	server := fs.String("s", cfg.Factomd, "The location of the factomd api")
+	in := fs.String("in", cfg.Files.EthDates, "Ethereum cost file, dated to keep the dates")
+	outName := fs.String("out", cfg.Files.EthSpread, "Output file")
+	rule := fs.String("rule", string(anchorcost.SpreadEven), "How the cost is split over a window: even, first (lowest height) or last (anchored height)")
+	fs.Parse(args)
+
+	switch r := anchorcost.SpreadRule(*rule); r {
+	case anchorcost.SpreadEven, anchorcost.SpreadFirst, anchorcost.SpreadLast:
+	default:
+		return usagef("unknown spread rule %q", *rule)
+	}
+
+	costs, err := anchorcost.LoadCosts(*in)
+	if err != nil {
+		return err
+	}
+	factom.SetFactomdServer(*server)
+
+	out, err := os.Create(*outName)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	dated := len(costs) > 0 && !costs[0].TxTime.IsZero()
+	header := "Height,TxID,Fee,DBHeightMin,DBHeightMax"
+	if dated {
+		header += ",TxDate"
+	}
+	if _, err := fmt.Fprintln(out, header); err != nil {
+		return err
+	}
+
+	for _, c := range costs {
+		if interrupted() {
+			return errInterrupted
+		}
+
+		wei, err := anchorcost.ParseWei(c.Amount)
+		if err != nil {
+			return fmt.Errorf("%s: %w", c.Hash, err)
+		}
+		min, max, err := ethWindow(int64(c.Height), c.Hash)
+		if err != nil {
+			return fmt.Errorf("height %d: %s: %w", c.Height, c.Hash, err)
+		}
+		shares, err := anchorcost.Spread(wei, min, max, anchorcost.SpreadRule(*rule))
+		if err != nil {
+			return fmt.Errorf("%s: %w", c.Hash, err)
+		}
+
+		for i, share := range shares {
+			if share.Sign() == 0 {
+				continue
+			}
+			line := fmt.Sprintf("%d,%s,%s,%d,%d", min+int64(i), c.Hash, anchorcost.FormatWei(share), min, max)
+			if dated {
+				line += "," + c.TxTime.Format(anchorcost.TimeFormat)
+			}
+			if _, err := fmt.Fprintln(out, line); err != nil {
+				return err
+			}
+		}
+	}
+	return out.Close()
+}
+
+// ethWindow returns the window of directory block heights that the
+// ethereum anchor of a height covers. If factomd does not report txid as the
+// anchor of the height, the window is the height alone.
+func ethWindow(height int64, txid string) (int64, int64, error) {
+	a, err := apiAnchors(height)
+	if errors.Is(err, anchorcost.ErrNotFound) {
+		a = nil
+	} else if err != nil {
+		return 0, 0, err
+	}
+
+	if a == nil || a.Ethereum == nil || !strings.EqualFold(strings.TrimPrefix(a.Ethereum.TxID, "0x"), strings.TrimPrefix(txid, "0x")) {
+		fmt.Println("no window for", txid, "at height", height, "attributing it to the height alone")
+		return height, height, nil
+	}
+	return a.Ethereum.DBHeightMin, a.Ethereum.DBHeightMax, nil
+}
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/WhoSoup/factom-anchor-cost/anchorcost"
+)
+
+func stitch(args []string) error {
+	fs := flag.NewFlagSet("stitch", flag.ExitOnError)
+	btcIn := fs.String("btc-in", cfg.Files.BTCDates, "Dated bitcoin cost file")
+	ethIn := fs.String("eth-in", cfg.Files.EthDates, "Dated ethereum cost file, or one written by spread")
+	btcPrices := fs.String("btc-prices", cfg.Files.BTCPrices, "Daily BTC/USD prices")
+	ethPrices := fs.String("eth-prices", cfg.Files.EthPrices, "Daily ETH/USD prices")
+	blockTimes := fs.String("blocktimes", cfg.Files.BlockTimes, "JSON map of factom heights to block times")
+	btcOut := fs.String("btc-out", cfg.Files.BTCStitch, "Bitcoin output file")
+	ethOut := fs.String("eth-out", cfg.Files.EthStitch, "Ethereum output file")
+	fromS := fs.String("from", "", "Only include transactions on or after this date (YYYY-MM-DD)")
+	toS := fs.String("to", "", "Only include transactions before this date (YYYY-MM-DD)")
+	fs.Parse(args)
+
+	var from, to time.Time
+	var err error
+	if *fromS != "" {
+		if from, err = time.Parse("2006-01-02", *fromS); err != nil {
+			return usagef("invalid -from date: %v", err)
+		}
+	}
+	if *toS != "" {
+		if to, err = time.Parse("2006-01-02", *toS); err != nil {
+			return usagef("invalid -to date: %v", err)
+		}
+	}
+
+	btcPrice, err := anchorcost.LoadPrices(*btcPrices)
+	if err != nil {
+		return err
+	}
+	ethPrice, err := anchorcost.LoadPrices(*ethPrices)
+	if err != nil {
+		return err
+	}
+	blocktimes, err := anchorcost.LoadBlockTimes(*blockTimes)
+	if err != nil {
+		return err
+	}
+	btc, err := anchorcost.LoadCosts(*btcIn)
+	if err != nil {
+		return err
+	}
+	eth, err := anchorcost.LoadCosts(*ethIn)
+	if err != nil {
+		return err
+	}
+
+	if err := stitchFile(*btcOut, "BTC", btcPrice, blocktimes, dateFilter(btc, from, to)); err != nil {
+		return err
+	}
+	return stitchFile(*ethOut, "ETH", ethPrice, blocktimes, dateFilter(eth, from, to))
+}
+
+func dateFilter(costs []anchorcost.Fee, from, to time.Time) []anchorcost.Fee {
+	var res []anchorcost.Fee
+	for _, c := range costs {
+		if !from.IsZero() && c.TxTime.Before(from) {
+			continue
+		}
+		if !to.IsZero() && !c.TxTime.Before(to) {
+			continue
+		}
+		res = append(res, c)
+	}
+	return res
+}
+
+func stitchFile(out, symbol string, prices map[time.Time]float64, blocktimes map[int]time.Time, costs []anchorcost.Fee) error {
+	f, err := os.Create(out)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if _, err := fmt.Fprintln(f, "BlockTime,TxTime,Price,Fee,FeeUSD,Cumulative,CumulativeUSD"); err != nil {
+		return err
+	}
+	cum := 0.0
+	cumusd := 0.0
+
+	for _, c := range costs {
+		bt := blocktimes[c.Height]
+
+		t := c.TxTime
+		hour := t.Add(-time.Duration(t.Minute()) * time.Minute)
+		hour = hour.Add(-time.Duration(t.Hour()) * time.Hour)
+
+		price := prices[hour]
+
+		val := c.Fee * price
+		cum += c.Fee
+		cumusd += val
+
+		if _, err := fmt.Fprintf(f, "%s,%s,%f,%s,%f,%f,%f\n", bt.Format(anchorcost.TimeFormat), t.Format(anchorcost.TimeFormat), price, c.Amount, val, cum, cumusd); err != nil {
+			return err
+		}
+	}
+	return f.Close()
+}
@@ -0,0 +1,274 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/FactomProject/factom"
+	"github.com/WhoSoup/factom-anchor-cost/anchorcost"
+	"github.com/WhoSoup/factom-anchor-cost/anchorcost/anchorabi"
+)
+
+// verify results
+const (
+	verifyMatch    = "match"
+	verifyMismatch = "mismatch" // factomd has a different KeyMR at the height
+	verifyMissing  = "missing"  // factomd has no directory block at the height
+	verifyInvalid  = "invalid"  // the transaction has no anchor payload
+)
+
+func verify(args []string) error {
+	fs := flag.NewFlagSet("verify", flag.ExitOnError)
+	server := fs.String("s", cfg.Factomd, "The location of the factomd api")
+	chain := fs.String("chain", "btc", "The chain to verify: btc or eth")
+	in := fs.String("in", "", "Orphans file, or a cost file whose transactions are looked up (default the orphans file for btc, the cost file for eth)")
+	outName := fs.String("out", cfg.Files.Verify, "Output file")
+	var heights heightRange
+	heights.register(fs)
+	var providers providerFlags
+	providers.registerBTC(fs)
+	providers.registerEth(fs)
+	fs.Parse(args)
+	defer providers.close()
+
+	factom.SetFactomdServer(*server)
+	switch *chain {
+	case "btc":
+		if *in == "" {
+			*in = cfg.Files.Orphans
+		}
+	case "eth":
+		if *in == "" {
+			*in = cfg.Files.EthCosts
+		}
+		return verifyEth(*in, *outName, heights, &providers)
+	default:
+		return usagef("unknown chain %q", *chain)
+	}
+
+	anchors, err := loadVerifyInput(*in, heights, &providers)
+	if err != nil {
+		return err
+	}
+
+	out, err := os.Create(*outName)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+	if _, err := fmt.Fprintln(out, "TxID,Height,KeyMR,FactomKeyMR,Result"); err != nil {
+		return err
+	}
+
+	counts := make(map[string]int)
+	keymrs := make(map[int]string) // factomd's KeyMR by height, "" if missing
+	for _, a := range anchors {
+		if !heights.contains(int64(a.Height)) {
+			continue
+		}
+		if interrupted() {
+			return errInterrupted
+		}
+
+		var factomKeyMR string
+		result := verifyInvalid
+		if a.KeyMR != "" {
+			var ok bool
+			if factomKeyMR, ok = keymrs[a.Height]; !ok {
+				if factomKeyMR, err = dblockKeyMR(int64(a.Height)); err != nil {
+					return fmt.Errorf("%s: height %d: %w", a.TxID, a.Height, err)
+				}
+				keymrs[a.Height] = factomKeyMR
+			}
+
+			switch {
+			case factomKeyMR == "":
+				result = verifyMissing
+			case strings.EqualFold(factomKeyMR, a.KeyMR):
+				result = verifyMatch
+			default:
+				result = verifyMismatch
+			}
+		}
+
+		counts[result]++
+		if _, err := fmt.Fprintf(out, "%s,%d,%s,%s,%s\n", a.TxID, a.Height, a.KeyMR, factomKeyMR, result); err != nil {
+			return err
+		}
+		if result != verifyMatch {
+			fmt.Println(result, a.TxID, a.Height)
+		}
+	}
+
+	if err := out.Close(); err != nil {
+		return err
+	}
+	return verifyTotals(counts)
+}
+
+// loadVerifyInput reads the anchors of an orphans file or, for a cost file,
+// decodes them from the transactions. Anchors without a payload are
+// returned with an empty KeyMR.
+func loadVerifyInput(name string, heights heightRange, providers *providerFlags) ([]anchorcost.Anchor, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	header, err := bufio.NewReader(f).ReadString('\n')
+	f.Close()
+	if err != nil {
+		return nil, &anchorcost.ParseError{File: name, Line: 1, Err: err}
+	}
+
+	if strings.HasPrefix(header, "TxID") {
+		return anchorcost.LoadAnchors(name)
+	}
+
+	costs, err := anchorcost.LoadCosts(name)
+	if err != nil {
+		return nil, err
+	}
+	btc, err := providers.btcProvider()
+	if err != nil {
+		return nil, err
+	}
+
+	var res []anchorcost.Anchor
+	for _, c := range costs {
+		if !heights.contains(int64(c.Height)) {
+			continue
+		}
+		if interrupted() {
+			return nil, errInterrupted
+		}
+
+		tx, err := btc.Tx(c.Hash)
+		if err != nil {
+			return nil, fmt.Errorf("height %d: %s: %w", c.Height, c.Hash, err)
+		}
+
+		a := anchorcost.Anchor{TxID: c.Hash, Height: c.Height, TxTime: tx.Time}
+		if anchor, err := txAnchor(tx); err == nil {
+			a.Height, a.KeyMR = int(anchor.Height), anchor.KeyMRString()
+		}
+		res = append(res, a)
+	}
+	return res, nil
+}
+
+// verifyEth decodes the anchor calls of the transactions in an ethereum
+// cost file and compares them to the windows factomd reports
+func verifyEth(in, outName string, heights heightRange, providers *providerFlags) error {
+	costs, err := anchorcost.LoadCosts(in)
+	if err != nil {
+		return err
+	}
+	eth, err := providers.ethProvider()
+	if err != nil {
+		return err
+	}
+	dec, err := ethAnchorDecoder()
+	if err != nil {
+		return err
+	}
+	classifier := newOrphanClassifier(apiAnchors)
+
+	out, err := os.Create(outName)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+	if _, err := fmt.Fprintln(out, "TxID,DBHeightMin,DBHeightMax,WindowMR,FactomWindowMR,Class,Result"); err != nil {
+		return err
+	}
+
+	counts := make(map[string]int)
+	for _, c := range costs {
+		if !heights.contains(int64(c.Height)) {
+			continue
+		}
+		if interrupted() {
+			return errInterrupted
+		}
+
+		tx, err := eth.Tx(c.Hash)
+		if err != nil {
+			return fmt.Errorf("height %d: %s: %w", c.Height, c.Hash, err)
+		}
+
+		// the columns of the call stay empty if it is not an anchor
+		var minCol, maxCol, root, class, factomMR string
+		result := verifyInvalid
+		if a, err := dec.Anchor(tx.Input); err != nil {
+			fmt.Println(c.Hash, err)
+		} else {
+			minCol, maxCol, root = fmt.Sprint(a.DBHeightMin), fmt.Sprint(a.DBHeightMax), a.WindowMRString()
+			var e *factom.AnchorEthereum
+			if class, e, err = classifier.classifyEth(c.Hash, a); err != nil {
+				return fmt.Errorf("%s: %w", c.Hash, err)
+			}
+			result = ethVerifyResult(e, a)
+			if e != nil {
+				factomMR = strings.TrimPrefix(e.WindowMR, "0x")
+			}
+		}
+
+		counts[result]++
+		if _, err := fmt.Fprintf(out, "%s,%s,%s,%s,%s,%s,%s\n", c.Hash, minCol, maxCol, root, factomMR, class, result); err != nil {
+			return err
+		}
+		if result != verifyMatch {
+			fmt.Println(result, c.Hash, c.Height)
+		}
+	}
+
+	if err := out.Close(); err != nil {
+		return err
+	}
+	return verifyTotals(counts)
+}
+
+// verifyTotals prints the count of each result and fails if any anchor
+// does not match factomd
+func verifyTotals(counts map[string]int) error {
+	fmt.Printf("%d match, %d mismatch, %d missing, %d invalid\n", counts[verifyMatch], counts[verifyMismatch], counts[verifyMissing], counts[verifyInvalid])
+	if bad := counts[verifyMismatch] + counts[verifyMissing]; bad > 0 {
+		return fmt.Errorf("%d anchors do not match factomd", bad)
+	}
+	return nil
+}
+
+// ethVerifyResult compares a decoded anchor call to factomd's anchor. The
+// class only says whether factomd names the transaction, even the canonical
+// one has to commit to the window factomd reports.
+func ethVerifyResult(e *factom.AnchorEthereum, anchor *anchorabi.Anchor) string {
+	switch {
+	case e == nil:
+		return verifyMissing
+	case ethWindowMatch(e, anchor):
+		return verifyMatch
+	}
+	return verifyMismatch
+}
+
+// dblockKeyMR returns the KeyMR of the directory block at height or an empty
+// string if factomd does not have it
+func dblockKeyMR(height int64) (string, error) {
+	var keymr string
+	err := factomRetry(func() error {
+		db, _, err := factom.GetDBlockByHeight(height)
+		if err != nil {
+			return err
+		}
+		keymr = db.KeyMR
+		return nil
+	})
+	if errors.Is(err, anchorcost.ErrNotFound) {
+		return "", nil
+	}
+	return keymr, err
+}